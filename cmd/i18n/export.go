@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nfs"
+	"io"
+	"os"
+)
+
+func runExport(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "lang", "root directory of catalog")
+	lang := flags.String("lang", "en", "language to export")
+	format := flags.String("format", "yaml", "output format, yaml or json")
+	fallback := flags.String("fallback", "", "comma-separated fallback chain, fill paths which language doesn't have")
+	expand := flags.Bool("expand", false, "replace templates with values they refer to")
+	common := flags.String("common", "", "directory of the common layer")
+	namespaced := flags.Bool("namespaced", false, "values of each file are under keys of its relative path")
+	output := flags.String("o", "", "file to write, print to stdout if it's empty")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: i18n export [flags]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	content, err := export(*dir, *lang, i18n.ExportFormat(*format), splitList(*fallback), *expand, *common, *namespaced)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n export: %v\n", err)
+		return exitError
+	}
+	if *output == "" {
+		_, _ = stdout.Write(content)
+		return exitOK
+	}
+	if err = os.WriteFile(*output, content, 0o644); err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n export: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
+
+func export(
+	dir string, lang string, format i18n.ExportFormat,
+	fallbackChain []string, expand bool, common string, namespaced bool,
+) ([]byte, error) {
+	fbLang := lang
+	if len(fallbackChain) > 0 {
+		fbLang = fallbackChain[0]
+	}
+	err := i18n.Init(i18n.Opts{
+		FSOpts: i18n.FSOpts{
+			FSMode:     i18nfs.ModeFileSystem,
+			Prefix:     dir,
+			Namespaced: namespaced,
+		},
+		DefaultLang:  lang,
+		FallbackLang: fbLang,
+		Languages:    append([]string{lang}, fallbackChain...),
+		CommonLang:   common,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(fallbackChain) > 0 {
+		if err = i18n.SetFallbackChain(fallbackChain...); err != nil {
+			return nil, err
+		}
+	}
+	return i18n.Export(lang, format, i18n.ExportOpts{
+		Fallback:        len(fallbackChain) > 0,
+		ExpandTemplates: expand,
+	})
+}
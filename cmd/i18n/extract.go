@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/hanakogo/i18n/i18nextract"
+	"github.com/hanakogo/i18n/i18nfs"
+	"io"
+)
+
+func runExtract(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("extract", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "", "root directory of catalog, only list used paths if it's empty")
+	lang := flags.String("lang", "en", "language of catalog to compare with")
+	tests := flags.Bool("tests", false, "also extract from _test.go files")
+	unused := flags.Bool("unused", true, "report paths of catalog which are never used")
+	namespaced := flags.Bool("namespaced", false, "values of each file are under keys of its relative path")
+	conflict := flags.String("conflict", "last-wins", "policy of paths defined by more than one file: last-wins, first-wins, warn or error")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: i18n extract [flags] [packages]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+	policy, err := i18nfs.ParseConflictPolicy(*conflict)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n extract: %v\n", err)
+		return exitError
+	}
+	patterns := flags.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	usages, err := i18nextract.Extract(i18nextract.Opts{Tests: *tests}, patterns...)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n extract: %v\n", err)
+		return exitError
+	}
+
+	if *dir == "" {
+		for _, usage := range usages {
+			_, _ = fmt.Fprintf(stdout, "%s:%d:%d: %s %s\n", usage.File, usage.Line, usage.Column, usage.Func, usage.Path)
+		}
+		return exitOK
+	}
+
+	report, err := i18nextract.Check(usages, *dir, *lang, i18nextract.CheckOpts{Namespaced: *namespaced, ConflictPolicy: policy})
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n extract: %v\n", err)
+		return exitError
+	}
+	problems := len(report.Undefined)
+	for _, usage := range report.Undefined {
+		_, _ = fmt.Fprintf(stdout, "%s:%d:%d: [undefined] path [%s] is not defined in language [%s]\n",
+			usage.File, usage.Line, usage.Column, usage.Path, *lang)
+	}
+	if *unused {
+		problems += len(report.Unused)
+		for _, key := range report.Unused {
+			_, _ = fmt.Fprintf(stdout, "%s:%d:%d: [unused] path [%s] is never used\n",
+				key.File, key.Line, key.Column, key.Path)
+		}
+	}
+	if problems > 0 {
+		_, _ = fmt.Fprintf(stderr, "i18n extract: found %d problem(s)\n", problems)
+		return exitProblem
+	}
+	return exitOK
+}
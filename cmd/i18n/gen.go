@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/i18ngen"
+	"io"
+	"os"
+)
+
+func runGen(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "lang", "root directory of catalog")
+	lang := flags.String("lang", "en", "language which types of values are taken from")
+	pkg := flags.String("pkg", "msgs", "name of generated package")
+	output := flags.String("o", "", "file to write, print to stdout if it's empty")
+	namespaced := flags.Bool("namespaced", false, "values of each file are under keys of its relative path")
+	conflict := flags.String("conflict", "last-wins", "policy of paths defined by more than one file: last-wins, first-wins, warn or error")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "Usage: i18n gen [flags]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+	policy, err := i18nfs.ParseConflictPolicy(*conflict)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n gen: %v\n", err)
+		return exitError
+	}
+
+	source, err := i18ngen.Generate(i18ngen.Opts{
+		Dir:            *dir,
+		Lang:           *lang,
+		Package:        *pkg,
+		Namespaced:     *namespaced,
+		ConflictPolicy: policy,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n gen: %v\n", err)
+		return exitError
+	}
+	if *output == "" {
+		_, _ = stdout.Write(source)
+		return exitOK
+	}
+	if err = os.WriteFile(*output, source, 0o644); err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n gen: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/i18nlint"
+	"io"
+	"strings"
+)
+
+func runLint(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dir := flags.String("dir", "lang", "root directory of catalog")
+	defaultLang := flags.String("default", "en", "default language, other languages are compared with it")
+	languages := flags.String("langs", "", "comma-separated languages to lint (default all subdirectories of dir)")
+	common := flags.String("common", "", "directory of the common layer")
+	namespaced := flags.Bool("namespaced", false, "values of each file are under keys of its relative path")
+	conflict := flags.String("conflict", "last-wins", "policy of paths defined by more than one file: last-wins, first-wins, warn or error")
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+	policy, err := i18nfs.ParseConflictPolicy(*conflict)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n lint: %v\n", err)
+		return exitError
+	}
+
+	diagnostics, err := i18nlint.Lint(i18nlint.Opts{
+		Dir:            *dir,
+		DefaultLang:    *defaultLang,
+		Languages:      splitList(*languages),
+		CommonLang:     *common,
+		Namespaced:     *namespaced,
+		ConflictPolicy: policy,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "i18n lint: %v\n", err)
+		return exitError
+	}
+
+	for _, diagnostic := range diagnostics {
+		_, _ = fmt.Fprintln(stdout, diagnostic)
+	}
+	if len(diagnostics) > 0 {
+		_, _ = fmt.Fprintf(stderr, "i18n lint: found %d problem(s)\n", len(diagnostics))
+		return exitProblem
+	}
+	return exitOK
+}
+
+// splitList split comma-separated list, empty items are dropped
+func splitList(s string) (list []string) {
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return
+}
@@ -0,0 +1,72 @@
+// Command i18n is a toolbox for catalogs of github.com/hanakogo/i18n
+//
+// Usage:
+//
+//	i18n <command> [flags]
+//
+// Commands:
+//
+//	lint    check catalog for problems, exit with non-zero code if there is any
+//	extract extract paths used by go source, and compare them with catalog
+//	gen     generate a go package with typed accessors of catalog
+//	export  print merged values of a language as YAML or JSON
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	exitOK      = 0
+	exitProblem = 1
+	exitError   = 2
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdout io.Writer, stderr io.Writer) int
+}
+
+var commands = []command{
+	{name: "lint", usage: "check catalog for problems", run: runLint},
+	{name: "extract", usage: "extract used paths from go source and compare them with catalog", run: runExtract},
+	{name: "gen", usage: "generate a go package with typed accessors of catalog", run: runGen},
+	{name: "export", usage: "print merged values of a language as YAML or JSON", run: runExport},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return exitError
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(args[1:], stdout, stderr)
+		}
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printUsage(stdout)
+		return exitOK
+	}
+	_, _ = fmt.Fprintf(stderr, "i18n: unknown command %q\n", args[0])
+	printUsage(stderr)
+	return exitError
+}
+
+func printUsage(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "Usage: i18n <command> [flags]")
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(w, "  %-8s %s\n", cmd.name, cmd.usage)
+	}
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, `Run "i18n <command> -h" for flags of command.`)
+}
@@ -0,0 +1,16 @@
+// Command i18nvet validates paths passed to package github.com/hanakogo/i18n, it can be used by go vet:
+//
+//	go install github.com/hanakogo/i18n/cmd/i18nvet@latest
+//	go vet -vettool=$(which i18nvet) -i18n.catalog=lang -i18n.lang=en ./...
+//
+// flags can be omitted if there is a config file named ".i18n.yaml", see package i18nanalysis.
+package main
+
+import (
+	"github.com/hanakogo/i18n/i18nanalysis"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(i18nanalysis.Analyzer)
+}
@@ -1,16 +1,18 @@
 module github.com/hanakogo/i18n
 
-go 1.21.4
+go 1.22.0
 
 require (
 	github.com/gookit/goutil v0.6.14
+	golang.org/x/sync v0.8.0
+	golang.org/x/text v0.14.0
+	golang.org/x/tools v0.26.0
 	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/gookit/color v1.5.4 // indirect
 	github.com/xo/terminfo v0.0.0-20220910002029-abceb7e1c41e // indirect
-	golang.org/x/sync v0.5.0 // indirect
-	golang.org/x/sys v0.14.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sys v0.26.0 // indirect
 )
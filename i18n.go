@@ -27,6 +27,8 @@ func Load(lang string) (err error) {
 	return
 }
 
+// HasPath check path exists in languages (all loaded languages if it's empty),
+// values inherited from the common layer are counted, use Origin to know which layer a value comes from
 func HasPath(path string, languages ...string) (ok bool, contains []string) {
 	status.MustInitialized()
 
@@ -47,6 +49,22 @@ func HasPath(path string, languages ...string) (ok bool, contains []string) {
 	return
 }
 
+// Origin get which layer value of path in lang comes from, it's lang itself or Opts.CommonLang
+func Origin(lang string, path string) (layer string, ok bool) {
+	status.MustInitialized()
+
+	origin, err := i18nFS.Origin(lang, path)
+	return origin.Name, err == nil
+}
+
+// Source get name of source (FSOpts.Name) which value of path in lang is read from
+func Source(lang string, path string) (source string, ok bool) {
+	status.MustInitialized()
+
+	origin, err := i18nFS.Origin(lang, path)
+	return origin.Source, err == nil
+}
+
 func getAnyOfLang(lang string, path string) (value any) {
 	status.MustInitialized()
 
@@ -108,7 +126,7 @@ func GetStringTrF(lang string, path string, args ...any) (stringVal string) {
 		return
 	}
 
-	return fmt.Sprintf(stringVal, args...)
+	return sprintf(lang, stringVal, args...)
 }
 
 func GetInt64Tr(lang string, path string, def ...int64) int64 {
@@ -149,9 +167,9 @@ func GetSliceTr[T comparable](lang string, path string, convertFunc ConvertFunc[
 		return defRes
 	}
 
-	if valueType := reflect.TypeOf(value); valueType.Kind() == reflect.Slice {
+	if sliceVal, ok := value.([]any); ok {
 		var resSlice []T
-		for _, elem := range value.([]any) {
+		for _, elem := range sliceVal {
 			resSlice = append(resSlice, convertFunc(elem))
 		}
 		return resSlice
@@ -161,84 +179,87 @@ func GetSliceTr[T comparable](lang string, path string, convertFunc ConvertFunc[
 }
 
 func GetValue(path string, def ...any) (val any, err error) {
-	val, err = GetValueTr(DefaultLang, path, def...)
-	if err != nil {
-		return
+	if len(def) == 0 {
+		return GetValueTr(GetDefaultLang(), path)
 	}
 	defRes := def[0]
-
-	// fallback
-	if defRes == val {
-		val, err = GetValueTr(FallbackLang, path, def...)
-		if err != nil {
-			return defRes, nil
+	for _, lang := range getLangs().lookup {
+		// fallback to next language if not found
+		if val, _ = GetValueTr(lang, path, def...); defRes != val {
+			return val, nil
 		}
 	}
 
-	return
+	return defRes, nil
 }
 
 func Get[T any](path string, convertFunc ConvertFunc[T], def T) (val T) {
-	value := GetTr[T](DefaultLang, path, convertFunc, def)
-	if reflect.DeepEqual(value, def) {
-		value = GetTr[T](FallbackLang, path, convertFunc, def)
-		if reflect.DeepEqual(value, def) {
-			return def
+	for _, lang := range getLangs().lookup {
+		value := GetTr[T](lang, path, convertFunc, def)
+		if !reflect.DeepEqual(value, def) {
+			return value
 		}
 	}
 
-	return value
+	return def
 }
 
 func GetString(path string, def ...string) (stringVal string) {
-	stringVal = GetStringTr(DefaultLang, path, def...)
-
-	// fallback
-	if stringVal == requireDefault[string](DefaultString, def...) {
-		stringVal = GetStringTr(FallbackLang, path, def...)
+	defRes := requireDefault[string](DefaultString, def...)
+	for _, lang := range getLangs().lookup {
+		// fallback to next language if not found
+		if stringVal = GetStringTr(lang, path, def...); stringVal != defRes {
+			return
+		}
 	}
 
-	return
+	return defRes
 }
 
+// GetStringF same as GetStringTrF, but fallback to languages of fallback chain,
+// string is formatted for the language which it's found in
 func GetStringF(path string, args ...any) (stringVal string) {
-	stringVal = GetString(path, DefaultString)
-	if stringVal == DefaultString {
-		return
+	for _, lang := range getLangs().lookup {
+		if stringVal = GetStringTrF(lang, path, args...); stringVal != DefaultString {
+			return
+		}
 	}
 
-	return fmt.Sprintf(stringVal, args...)
+	return DefaultString
 }
 
 func GetInt64(path string, def ...int64) (intVal int64) {
-	intVal = GetInt64Tr(DefaultLang, path, def...)
-
-	// fallback
-	if intVal == requireDefault[int64](DefaultInt, def...) {
-		intVal = GetInt64Tr(FallbackLang, path, def...)
+	defRes := requireDefault[int64](DefaultInt, def...)
+	for _, lang := range getLangs().lookup {
+		// fallback to next language if not found
+		if intVal = GetInt64Tr(lang, path, def...); intVal != defRes {
+			return
+		}
 	}
 
-	return
+	return defRes
 }
 
 func GetFloat(path string, def ...float64) (floatVal float64) {
-	floatVal = GetFloatTr(DefaultLang, path, def...)
-
-	// fallback
-	if floatVal == requireDefault[float64](DefaultFloat, def...) {
-		floatVal = GetFloatTr(FallbackLang, path, def...)
+	defRes := requireDefault[float64](DefaultFloat, def...)
+	for _, lang := range getLangs().lookup {
+		// fallback to next language if not found
+		if floatVal = GetFloatTr(lang, path, def...); floatVal != defRes {
+			return
+		}
 	}
 
-	return
+	return defRes
 }
 
 func GetSlice[T comparable](path string, convertFunc ConvertFunc[T], def ...[]T) (valueList []T) {
-	valueList = GetSliceTr[T](DefaultLang, path, convertFunc, def...)
-
-	// fallback
-	if slices.Equal(valueList, requireDefault[[]T]([]T{}, def...)) {
-		valueList = GetSliceTr[T](FallbackLang, path, convertFunc, def...)
+	defRes := requireDefault[[]T]([]T{}, def...)
+	for _, lang := range getLangs().lookup {
+		// fallback to next language if not found
+		if valueList = GetSliceTr[T](lang, path, convertFunc, def...); !slices.Equal(valueList, defRes) {
+			return
+		}
 	}
 
-	return valueList
+	return defRes
 }
@@ -0,0 +1,28 @@
+package i18n
+
+import (
+	"github.com/hanakogo/i18n/internal/status"
+	"slices"
+	"strings"
+)
+
+// Override a path which is defined by more than one file of a language, Winner is the file whose value is in effect
+type Override struct {
+	Path   string
+	Winner string
+	Loser  string
+}
+
+// Overrides list paths of loaded language which are defined by more than one file, sorted by path,
+// which file wins is decided by Opts.ConflictPolicy
+func Overrides(lang string) (overrides []Override) {
+	status.MustInitialized()
+
+	for _, override := range i18nFS.GetOverrides(lang) {
+		overrides = append(overrides, Override(override))
+	}
+	slices.SortStableFunc(overrides, func(a, b Override) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return
+}
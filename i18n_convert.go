@@ -1,38 +1,178 @@
 package i18n
 
 import (
+	"fmt"
 	"github.com/gookit/goutil"
 	"github.com/gookit/goutil/mathutil"
+	"github.com/gookit/goutil/strutil"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type ConvertFunc[T any] func(value any) T
 
+// ConvertFuncE is like ConvertFunc, but reports failure of converting by error instead of returning a sentinel
+type ConvertFuncE[T any] func(value any) (T, error)
+
+// BigFloatPrec precision (in bits) of *big.Float which is converted from value
+var BigFloatPrec uint = 256
+
 var (
-	ConvertString ConvertFunc[string]  = ConvertAnyToString
-	ConvertInt64  ConvertFunc[int64]   = ConvertAnyToInt64
-	ConvertFloat  ConvertFunc[float64] = ConvertAnyToFloat
+	ConvertString   ConvertFunc[string]        = ConvertAnyToString
+	ConvertInt64    ConvertFunc[int64]         = ConvertAnyToInt64
+	ConvertFloat    ConvertFunc[float64]       = ConvertAnyToFloat
+	ConvertBool     ConvertFunc[bool]          = ConvertAnyToBool
+	ConvertUint64   ConvertFunc[uint64]        = ConvertAnyToUint64
+	ConvertDuration ConvertFunc[time.Duration] = ConvertAnyToDuration
+	ConvertBigFloat ConvertFunc[*big.Float]    = ConvertAnyToBigFloat
 )
 
 func ConvertAnyToString(value any) string {
-	valString, err := goutil.ToString(value)
+	valString, err := ConvertAnyToStringE(value)
 	if err != nil {
 		return DefaultString
 	}
 	return valString
 }
 
+func ConvertAnyToStringE(value any) (string, error) {
+	return goutil.ToString(value)
+}
+
 func ConvertAnyToInt64(value any) int64 {
-	valInt, err := goutil.ToInt64(value)
+	valInt, err := ConvertAnyToInt64E(value)
 	if err != nil {
 		return DefaultInt
 	}
 	return valInt
 }
 
+func ConvertAnyToInt64E(value any) (int64, error) {
+	return goutil.ToInt64(value)
+}
+
 func ConvertAnyToFloat(value any) float64 {
-	valFloat, err := mathutil.ToFloat(value)
+	valFloat, err := ConvertAnyToFloatE(value)
 	if err != nil {
 		return DefaultFloat
 	}
 	return valFloat
 }
+
+func ConvertAnyToFloatE(value any) (float64, error) {
+	return mathutil.ToFloat(value)
+}
+
+func ConvertAnyToBool(value any) bool {
+	valBool, err := ConvertAnyToBoolE(value)
+	if err != nil {
+		return DefaultBool
+	}
+	return valBool
+}
+
+// ConvertAnyToBoolE accepts bool and strings like "true", "yes", "on", "1" (and their negations)
+func ConvertAnyToBoolE(value any) (bool, error) {
+	return goutil.ToBool(value)
+}
+
+func ConvertAnyToUint64(value any) uint64 {
+	valUint, err := ConvertAnyToUint64E(value)
+	if err != nil {
+		return DefaultUint
+	}
+	return valUint
+}
+
+// ConvertAnyToUint64E rejects negative values instead of wrapping them around
+func ConvertAnyToUint64E(value any) (uint64, error) {
+	switch val := value.(type) {
+	case uint64:
+		return val, nil
+	case string:
+		return strconv.ParseUint(strings.TrimSpace(val), 10, 64)
+	}
+	valInt, err := goutil.ToInt64(value)
+	if err != nil {
+		return DefaultUint, err
+	}
+	if valInt < 0 {
+		return DefaultUint, fmt.Errorf("negative value %d can't convert to uint64", valInt)
+	}
+	return uint64(valInt), nil
+}
+
+func ConvertAnyToDuration(value any) time.Duration {
+	valDuration, err := ConvertAnyToDurationE(value)
+	if err != nil {
+		return DefaultDuration
+	}
+	return valDuration
+}
+
+// ConvertAnyToDurationE accepts duration strings like "300ms", "1h30m" or "2d"
+func ConvertAnyToDurationE(value any) (time.Duration, error) {
+	if val, ok := value.(time.Duration); ok {
+		return val, nil
+	}
+	valString, err := goutil.ToString(value)
+	if err != nil {
+		return DefaultDuration, err
+	}
+	return strutil.ToDuration(strings.TrimSpace(valString))
+}
+
+// ConvertTimeLayout make a ConvertFuncE[time.Time] which parses string with layout,
+// time.RFC3339 is used if layout is empty
+func ConvertTimeLayout(layout string) ConvertFuncE[time.Time] {
+	return func(value any) (time.Time, error) {
+		return ConvertAnyToTimeE(value, layout)
+	}
+}
+
+// ConvertAnyToTimeE value which is already a YAML timestamp will be returned directly
+func ConvertAnyToTimeE(value any, layout string) (time.Time, error) {
+	if val, ok := value.(time.Time); ok {
+		return val, nil
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	valString, err := goutil.ToString(value)
+	if err != nil {
+		return DefaultTime, err
+	}
+	return time.Parse(layout, strings.TrimSpace(valString))
+}
+
+func ConvertAnyToBigFloat(value any) *big.Float {
+	valBigFloat, err := ConvertAnyToBigFloatE(value)
+	if err != nil {
+		return DefaultBigFloat
+	}
+	return valBigFloat
+}
+
+// ConvertAnyToBigFloatE parses value as a decimal number, so quoted strings keep all digits
+func ConvertAnyToBigFloatE(value any) (*big.Float, error) {
+	var valString string
+	switch val := value.(type) {
+	case *big.Float:
+		return val, nil
+	case float64:
+		valString = strconv.FormatFloat(val, 'g', -1, 64)
+	default:
+		var err error
+		valString, err = goutil.ToString(value)
+		if err != nil {
+			return DefaultBigFloat, err
+		}
+	}
+	valBigFloat, _, err := big.ParseFloat(strings.TrimSpace(valString), 10, BigFloatPrec, big.ToNearestEven)
+	if err != nil {
+		return DefaultBigFloat, err
+	}
+	return valBigFloat, nil
+}
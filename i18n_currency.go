@@ -0,0 +1,198 @@
+package i18n
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/cldr"
+	"golang.org/x/text/currency"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type CurrencyDisplay int
+
+const (
+	// CurrencySymbol symbol of currency in language like "$" or "US$"
+	CurrencySymbol CurrencyDisplay = iota
+	// CurrencyNarrowSymbol symbol without country like "$"
+	CurrencyNarrowSymbol
+	// CurrencyCode ISO 4217 code like "USD"
+	CurrencyCode
+)
+
+type CurrencyOpts struct {
+	Display CurrencyDisplay
+	// MinorUnits value is in minor units of currency, e.g. 123456 is 1234.56 of "EUR", 1234.567 of "BHD" and
+	// 123456 of "JPY"
+	MinorUnits bool
+}
+
+// FormatCurrency format amount of currency (ISO 4217 code like "EUR") for language, number has fraction digits of
+// minor units of currency (2 of "EUR", 0 of "JPY", 3 of "BHD"), symbol is placed as language does,
+// e.g. 1234.56 of "EUR" is "€1,234.56" in "en" and "1.234,56 €" in "de".
+// value can be an integer, a float, *big.Int, *big.Rat, *big.Float or a decimal string like "1234.56". it's computed
+// exactly and rounded half to even, floats are taken as their shortest decimal, so 2.675 is "$2.68" rather than
+// "$2.67" of its binary value
+func FormatCurrency(lang string, value any, code string, opts ...CurrencyOpts) (string, error) {
+	var currencyOpts CurrencyOpts
+	if len(opts) > 0 {
+		currencyOpts = opts[0]
+	}
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return "", fmt.Errorf("unknown currency [%s]", code)
+	}
+	amount, ok := exactAmount(value)
+	if !ok {
+		return "", fmt.Errorf("amount [%v] of currency is not a number", value)
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+	if currencyOpts.MinorUnits {
+		amount.Quo(amount, new(big.Rat).SetInt(pow10(scale)))
+	}
+	units := roundHalfEven(amount, scale)
+	formatted, ok := formatFixed(lang, units, scale)
+	if !ok {
+		return "", fmt.Errorf("amount [%v] of currency is too large", value)
+	}
+	// sign of language is kept in front of symbol, amount rounded to zero has no sign
+	var sign string
+	if amount.Sign() < 0 && units.Sign() != 0 {
+		sign = strings.TrimSuffix(FormatNumber(lang, -1), FormatNumber(lang, 1))
+	}
+
+	tag := langTag(lang)
+	printer := printerOf(tag)
+	var symbol string
+	switch currencyOpts.Display {
+	case CurrencyNarrowSymbol:
+		symbol = printer.Sprint(currency.NarrowSymbol(unit))
+	case CurrencyCode:
+		symbol = printer.Sprint(currency.ISO(unit))
+	default:
+		symbol = printer.Sprint(currency.Symbol(unit))
+	}
+
+	pattern := cldr.Currency(langCandidates(tag)...)
+	space := pattern.Space
+	if pattern.Before {
+		// letters of symbol like "CHF" are separated from number
+		if last, _ := utf8.DecodeLastRuneInString(symbol); space == "" && unicode.IsLetter(last) {
+			space = " "
+		}
+		return sign + symbol + space + formatted, nil
+	}
+	if first, _ := utf8.DecodeRuneInString(symbol); space == "" && unicode.IsLetter(first) {
+		space = " "
+	}
+	return sign + formatted + space + symbol, nil
+}
+
+// exactAmount convert value to an exact rational number, floats are converted from their shortest decimal
+func exactAmount(value any) (*big.Rat, bool) {
+	switch val := value.(type) {
+	case float32:
+		return parseDecimal(strconv.FormatFloat(float64(val), 'g', -1, 32))
+	case float64:
+		return parseDecimal(strconv.FormatFloat(val, 'g', -1, 64))
+	case uint:
+		return new(big.Rat).SetUint64(uint64(val)), true
+	case uint8:
+		return new(big.Rat).SetUint64(uint64(val)), true
+	case uint16:
+		return new(big.Rat).SetUint64(uint64(val)), true
+	case uint32:
+		return new(big.Rat).SetUint64(uint64(val)), true
+	case uint64:
+		return new(big.Rat).SetUint64(val), true
+	case *big.Int:
+		return new(big.Rat).SetInt(val), val != nil
+	case *big.Rat:
+		if val == nil {
+			return nil, false
+		}
+		return new(big.Rat).Set(val), true
+	case *big.Float:
+		if val == nil || val.IsInf() {
+			return nil, false
+		}
+		return parseDecimal(val.Text('g', -1))
+	case string:
+		return parseDecimal(val)
+	}
+	if isNumber(value) {
+		return new(big.Rat).SetInt64(ConvertAnyToInt64(value)), true
+	}
+	return nil, false
+}
+
+// parseDecimal parse decimal string like "-1234.56" or "1e3", NaN, infinity and fractions like "1/3" are not numbers
+func parseDecimal(s string) (*big.Rat, bool) {
+	if strings.ContainsAny(s, "/nN") {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// roundHalfEven absolute value of amount in units of 10^-scale, rounded half to even
+func roundHalfEven(amount *big.Rat, scale int) *big.Int {
+	scaled := new(big.Rat).Abs(amount)
+	scaled.Mul(scaled, new(big.Rat).SetInt(pow10(scale)))
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	switch rem.Lsh(rem, 1).Cmp(scaled.Denom()) {
+	case 1:
+		quo.Add(quo, big.NewInt(1))
+	case 0:
+		if quo.Bit(0) == 1 {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return quo
+}
+
+// formatFixed format units of 10^-scale for language with exactly scale fraction digits, integer part must fit in
+// uint64 to be formatted by x/text without losing digits
+func formatFixed(lang string, units *big.Int, scale int) (string, bool) {
+	whole, fraction := new(big.Int).QuoRem(units, pow10(scale), new(big.Int))
+	if !whole.IsUint64() {
+		return "", false
+	}
+	formatted := FormatNumber(lang, whole.Uint64())
+	if scale == 0 {
+		return formatted, true
+	}
+	// "0.78" of language gives decimal separator and digits, it's exact as a float within digits of minor units
+	fractionVal, _ := new(big.Rat).SetFrac(fraction, pow10(scale)).Float64()
+	fractionPart := FormatNumber(lang, fractionVal, NumberOpts{MinFractionDigits: scale, MaxFractionDigits: scale})
+	_, size := utf8.DecodeRuneInString(fractionPart)
+	return formatted + fractionPart[size:], true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// parseCurrencyStyle parse style of placeholder like "{price, currency, EUR}", code of currency can be followed by
+// "narrow" (narrow symbol), "code" (ISO 4217 code) and "minor" (value is in minor units), e.g. "USD code minor"
+func parseCurrencyStyle(style string) (code string, opts CurrencyOpts, ok bool) {
+	fields := strings.Fields(style)
+	if len(fields) == 0 {
+		return "", opts, false
+	}
+	for _, field := range fields[1:] {
+		switch field {
+		case "narrow":
+			opts.Display = CurrencyNarrowSymbol
+		case "code":
+			opts.Display = CurrencyCode
+		case "minor":
+			opts.MinorUnits = true
+		default:
+			return "", opts, false
+		}
+	}
+	return fields[0], opts, true
+}
@@ -0,0 +1,158 @@
+package i18n
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/cldr"
+	"golang.org/x/text/language"
+	"strings"
+	"time"
+)
+
+// DateStyle style of FormatDate, FormatTime and FormatDateTime, it's one of the styles below or a skeleton of CLDR
+// like "yMMMd" (year, abbreviated month and day) or "Hm" (hour of 24 hours and minute), "j" of skeleton is the
+// hour preferred by language
+type DateStyle string
+
+const (
+	// DateShort like "1/2/06" and "3:04 PM"
+	DateShort DateStyle = "short"
+	// DateMedium like "Jan 2, 2006" and "3:04:05 PM"
+	DateMedium DateStyle = "medium"
+	// DateLong like "January 2, 2006" and "3:04:05 PM MST"
+	DateLong DateStyle = "long"
+	// DateFull like "Monday, January 2, 2006" and "3:04:05 PM MST"
+	DateFull DateStyle = "full"
+)
+
+var dateStyles = map[DateStyle]cldr.Style{
+	DateShort:  cldr.Short,
+	DateMedium: cldr.Medium,
+	DateLong:   cldr.Long,
+	DateFull:   cldr.Full,
+}
+
+// FormatDate format date of t for language with month and weekday names of it, e.g. DateMedium is "Jan 2, 2006" in
+// "en" and "02.01.2006" in "de". empty style is DateMedium. hours of styles and "j" of skeletons follow preference of
+// language, unless it's set by "hc" extension of tag like "en-u-hc-h23" (24 hours) or "de-u-hc-h12" (12 hours).
+// error is returned if language has no data of dates (see DateLanguages) or style is a skeleton which it doesn't have
+func FormatDate(lang string, t time.Time, style DateStyle) (string, error) {
+	symbols, hourCycle, err := dateSymbolsOf(lang)
+	if err != nil {
+		return "", err
+	}
+	if isSkeleton(style) {
+		return formatSkeleton(lang, t, symbols, style, hourCycle)
+	}
+	return formatDatePattern(symbols.DateFormats[styleOf(style)], t, symbols, hourCycle), nil
+}
+
+// FormatTime same as FormatDate, but format time of t, e.g. DateShort is "3:04 PM" in "en" and "15:04" in "de"
+func FormatTime(lang string, t time.Time, style DateStyle) (string, error) {
+	symbols, hourCycle, err := dateSymbolsOf(lang)
+	if err != nil {
+		return "", err
+	}
+	if isSkeleton(style) {
+		return formatSkeleton(lang, t, symbols, style, hourCycle)
+	}
+	return formatDatePattern(symbols.TimeFormats[styleOf(style)], t, symbols, hourCycle), nil
+}
+
+// FormatDateTime same as FormatDate, but format both date and time of t in the same style and combine them as
+// language does, e.g. DateMedium is "Jan 2, 2006, 3:04:05 PM" in "en"
+func FormatDateTime(lang string, t time.Time, style DateStyle) (string, error) {
+	symbols, hourCycle, err := dateSymbolsOf(lang)
+	if err != nil {
+		return "", err
+	}
+	if isSkeleton(style) {
+		return formatSkeleton(lang, t, symbols, style, hourCycle)
+	}
+	dateStyle := styleOf(style)
+	date := formatDatePattern(symbols.DateFormats[dateStyle], t, symbols, hourCycle)
+	// time of long and full is too long to be combined
+	timeStyle := max(dateStyle, cldr.Medium)
+	timeOfDay := formatDatePattern(symbols.TimeFormats[timeStyle], t, symbols, hourCycle)
+	combined := cldr.FormatPattern(symbols.DateTimeFormats[dateStyle], t, symbols)
+	return strings.NewReplacer("{1}", date, "{0}", timeOfDay).Replace(combined), nil
+}
+
+// DateLanguages languages which have data of dates, languages with region like "en-US" use data of "en",
+// but data isn't shared by scripts, e.g. "zh" (simplified) isn't used for "zh-TW" (traditional)
+func DateLanguages() []string {
+	return cldr.DateLanguages()
+}
+
+// dateSymbolsOf symbols and hour cycle (empty if language doesn't set it) of language
+func dateSymbolsOf(lang string) (*cldr.DateSymbols, string, error) {
+	tag := langTag(lang)
+	// base of unknown language is guessed with low confidence
+	base, confidence := tag.Base()
+	script, _ := tag.Script()
+	defaultScript, _ := language.Make(base.String()).Script()
+	if confidence != language.Low && script == defaultScript {
+		if symbols, ok := cldr.Dates(langCandidates(tag)...); ok {
+			return symbols, tag.TypeForKey("hc"), nil
+		}
+	}
+	return nil, "", fmt.Errorf("language [%s] has no data of dates", lang)
+}
+
+func isSkeleton(style DateStyle) bool {
+	_, ok := dateStyles[style]
+	return !ok && style != ""
+}
+
+// formatSkeleton format t by pattern of skeleton of language, "j" of skeleton is replaced by hour of hour cycle,
+// or hour preferred by language if hour cycle is empty
+func formatSkeleton(
+	lang string, t time.Time, symbols *cldr.DateSymbols, style DateStyle, hourCycle string,
+) (string, error) {
+	skeleton := string(style)
+	if strings.Contains(skeleton, "j") {
+		hour := "H"
+		switch hourCycle {
+		case "h11", "h12":
+			hour = "h"
+		case "h23", "h24":
+		default:
+			if strings.ContainsAny(symbols.TimeFormats[cldr.Short], "hK") {
+				hour = "h"
+			}
+		}
+		skeleton = strings.ReplaceAll(skeleton, "j", hour)
+	}
+	pattern, ok := symbols.Skeletons[skeleton]
+	if !ok {
+		return "", fmt.Errorf("skeleton [%s] is not supported by language [%s]", style, lang)
+	}
+	return cldr.FormatPattern(pattern, t, symbols), nil
+}
+
+// styleOf style of CLDR, empty style is medium
+func styleOf(style DateStyle) cldr.Style {
+	if dateStyle, ok := dateStyles[style]; ok {
+		return dateStyle
+	}
+	return cldr.Medium
+}
+
+func formatDatePattern(pattern string, t time.Time, symbols *cldr.DateSymbols, hourCycle string) string {
+	if hourCycle != "" {
+		pattern = symbols.HourCycle(pattern, hourCycle)
+	}
+	return cldr.FormatPattern(pattern, t, symbols)
+}
+
+// dateFormatter formatter of placeholders like "{t, date, medium}" and "{t, time, ::Hm}", "::" of skeleton is
+// optional. placeholder is kept if language has no data of dates or skeleton
+func dateFormatter(format func(lang string, t time.Time, style DateStyle) (string, error)) placeholderFormatter {
+	return func(lang string, value any, style string) (string, bool) {
+		t, ok := value.(time.Time)
+		if !ok {
+			return "", false
+		}
+		result, err := format(lang, t, DateStyle(strings.TrimPrefix(style, "::")))
+		return result, err == nil
+	}
+}
@@ -1,9 +1,19 @@
 package i18n
 
+import (
+	"math/big"
+	"time"
+)
+
 var (
-	DefaultString string  = ""
-	DefaultInt    int64   = -1
-	DefaultFloat  float64 = -1.0
+	DefaultString   string        = ""
+	DefaultInt      int64         = -1
+	DefaultFloat    float64       = -1.0
+	DefaultBool     bool          = false
+	DefaultUint     uint64        = 0
+	DefaultDuration time.Duration = 0
+	DefaultTime     time.Time     = time.Time{}
+	DefaultBigFloat *big.Float    = nil
 )
 
 func requireDefault[T any](def T, defs ...T) T {
@@ -0,0 +1,204 @@
+package i18n
+
+import (
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"github.com/hanakogo/i18n/internal/utils"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DiffReport differences of target language compared with base language, all paths are in sorted order
+type DiffReport struct {
+	Base   string
+	Target string
+	// Missing paths which exist in base language but not in target language
+	Missing []string
+	// Extra paths which exist in target language but not in base language
+	Extra []string
+	// Inherited paths which base language defines itself, but target language inherits from the common layer
+	Inherited []string
+	// TypeMismatches paths whose value has different type in two languages
+	TypeMismatches []TypeMismatch
+	// PlaceholderMismatches paths whose string value has incompatible printf verbs or named placeholders in two languages
+	PlaceholderMismatches []PlaceholderMismatch
+}
+
+type TypeMismatch struct {
+	Path       string
+	BaseType   string
+	TargetType string
+}
+
+type PlaceholderMismatch struct {
+	Path string
+	// BasePlaceholders printf verbs followed by named placeholders of base, e.g. ["%d", "{name}"]
+	BasePlaceholders   []string
+	TargetPlaceholders []string
+	// Reason why placeholders of target are incompatible with base
+	Reason string
+}
+
+// Empty check there is no difference
+func (r *DiffReport) Empty() bool {
+	return len(r.Missing) == 0 && len(r.Extra) == 0 &&
+		len(r.TypeMismatches) == 0 && len(r.PlaceholderMismatches) == 0
+}
+
+// Diff compare merged values of target language with base language
+func Diff(base string, target string) (report *DiffReport, err error) {
+	if !status.Initialized {
+		return nil, errors.ErrorNotInitialized
+	}
+
+	baseValues, err := collectValues(base)
+	if err != nil {
+		return nil, err
+	}
+	targetValues, err := collectValues(target)
+	if err != nil {
+		return nil, err
+	}
+
+	report = &DiffReport{Base: base, Target: target}
+	typeMismatches := make(map[string]TypeMismatch)
+	for path, baseValue := range baseValues {
+		targetValue, ok := targetValues[path]
+		if !ok {
+			// target may have a value where base has an object
+			if prefix, value, found := findValuePrefix(target, baseValue.keys); found {
+				typeMismatches[prefix] = TypeMismatch{Path: prefix, BaseType: "object", TargetType: typeOfValue(value)}
+				continue
+			}
+			report.Missing = append(report.Missing, path)
+			continue
+		}
+
+		if baseValue.layer == base && targetValue.layer != target {
+			report.Inherited = append(report.Inherited, path)
+		}
+
+		baseType, targetType := typeOfValue(baseValue.value), typeOfValue(targetValue.value)
+		if baseType != targetType {
+			typeMismatches[path] = TypeMismatch{Path: path, BaseType: baseType, TargetType: targetType}
+			continue
+		}
+
+		if baseString, ok := baseValue.value.(string); ok {
+			targetString := targetValue.value.(string)
+			if err := utils.CompareFormats(baseString, targetString); err != nil {
+				report.PlaceholderMismatches = append(report.PlaceholderMismatches, PlaceholderMismatch{
+					Path:               path,
+					BasePlaceholders:   utils.RawFormats(baseString),
+					TargetPlaceholders: utils.RawFormats(targetString),
+					Reason:             err.Error(),
+				})
+			}
+		}
+	}
+	for path, targetValue := range targetValues {
+		if _, ok := baseValues[path]; ok {
+			continue
+		}
+		// base may have a value where target has an object
+		if prefix, value, found := findValuePrefix(base, targetValue.keys); found {
+			typeMismatches[prefix] = TypeMismatch{Path: prefix, BaseType: typeOfValue(value), TargetType: "object"}
+			continue
+		}
+		report.Extra = append(report.Extra, path)
+	}
+
+	for _, typeMismatch := range typeMismatches {
+		report.TypeMismatches = append(report.TypeMismatches, typeMismatch)
+	}
+
+	slices.Sort(report.Missing)
+	slices.Sort(report.Extra)
+	slices.Sort(report.Inherited)
+	slices.SortFunc(report.TypeMismatches, func(a, b TypeMismatch) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	slices.SortFunc(report.PlaceholderMismatches, func(a, b PlaceholderMismatch) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return report, nil
+}
+
+// Coverage percentage of values of default language which are translated with the same type in lang
+func Coverage(lang string) (float64, error) {
+	if !status.Initialized {
+		return 0, errors.ErrorNotInitialized
+	}
+
+	baseValues, err := collectValues(GetDefaultLang())
+	if err != nil {
+		return 0, err
+	}
+	if len(baseValues) == 0 {
+		return 100, nil
+	}
+	targetValues, err := collectValues(lang)
+	if err != nil {
+		return 0, err
+	}
+
+	translated := 0
+	for path, baseValue := range baseValues {
+		if targetValue, ok := targetValues[path]; ok && typeOfValue(baseValue.value) == typeOfValue(targetValue.value) {
+			translated++
+		}
+	}
+	return float64(translated) * 100 / float64(len(baseValues)), nil
+}
+
+type pathValue struct {
+	keys  []string
+	value any
+	// layer which value comes from
+	layer string
+}
+
+// collectValues collect all values of language include inherited ones, keyed by full path
+func collectValues(lang string) (map[string]pathValue, error) {
+	values := make(map[string]pathValue)
+	err := i18nFS.WalkLangLayers(lang, func(value any, path []string, layer string) {
+		values[utils.FormatPath(path...)] = pathValue{keys: path, value: value, layer: layer}
+	})
+	return values, err
+}
+
+// findValuePrefix find a value (not object) of language on the way of keys
+func findValuePrefix(lang string, keys []string) (prefix string, value any, found bool) {
+	for n := 1; n < len(keys); n++ {
+		value, ok := i18nFS.GetRawValue(lang, keys[:n])
+		if !ok {
+			return "", nil, false
+		}
+		if _, isMap := value.(map[string]any); !isMap {
+			return utils.FormatPath(keys[:n]...), value, true
+		}
+	}
+	return "", nil, false
+}
+
+// typeOfValue readable type name of YAML value
+func typeOfValue(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int64, uint64, float64:
+		return "number"
+	case time.Time:
+		return "timestamp"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	}
+	return "unknown"
+}
@@ -0,0 +1,66 @@
+package i18n
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"golang.org/x/text/language"
+	"slices"
+	"strings"
+)
+
+// InvalidLangDir a directory under prefix of a source whose name isn't a valid BCP 47 language tag
+type InvalidLangDir struct {
+	Source string
+	Dir    string
+	Err    error
+}
+
+// DiscoverError is returned by Init if Opts.DiscoverLanguages is enabled and any directory name is invalid
+type DiscoverError struct {
+	Invalid []InvalidLangDir
+}
+
+func (e *DiscoverError) Error() string {
+	var lines []string
+	for _, invalid := range e.Invalid {
+		lines = append(lines, fmt.Sprintf("directory [%s] of source [%s]: %v", invalid.Dir, invalid.Source, invalid.Err))
+	}
+	return fmt.Sprintf("%d directory name(s) are not valid language tags:\n%s", len(lines), strings.Join(lines, "\n"))
+}
+
+// DiscoverLanguages list languages of all sources by their directories, CommonLang is excluded.
+// every directory name must be a valid BCP 47 language tag like "en" or "zh-CN", otherwise *DiscoverError is returned
+func DiscoverLanguages() ([]string, error) {
+	if !status.Initialized {
+		return nil, errors.ErrorNotInitialized
+	}
+
+	dirs, err := i18nFS.ListLangDirs()
+	if err != nil {
+		return nil, err
+	}
+	var languages []string
+	var invalid []InvalidLangDir
+	for _, dir := range dirs {
+		if err := validateLangTag(dir.Name); err != nil {
+			invalid = append(invalid, InvalidLangDir{Source: dir.Source, Dir: dir.Name, Err: err})
+			continue
+		}
+		languages = append(languages, dir.Name)
+	}
+	if len(invalid) > 0 {
+		return nil, &DiscoverError{Invalid: invalid}
+	}
+	slices.Sort(languages)
+	return slices.Compact(languages), nil
+}
+
+func validateLangTag(name string) error {
+	// language.Parse accepts "_" as separator, but it's not a part of BCP 47
+	if strings.Contains(name, "_") {
+		return fmt.Errorf("subtags must be separated by \"-\"")
+	}
+	_, err := language.Parse(name)
+	return err
+}
@@ -0,0 +1,112 @@
+package i18n
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"github.com/hanakogo/i18n/internal/utils"
+	"gopkg.in/yaml.v3"
+	"slices"
+)
+
+type ExportFormat string
+
+const (
+	ExportYAML ExportFormat = "yaml"
+	ExportJSON ExportFormat = "json"
+)
+
+type ExportOpts struct {
+	// Fallback fill paths which language doesn't have with values of fallback chain, in order of the chain
+	Fallback bool
+	// ExpandTemplates replace templates like "${path}" of string values with values they refer to,
+	// values filled by fallback are expanded in context of their own language
+	ExpandTemplates bool
+}
+
+// Export marshal merged values of language (include values of overlays, the common layer and messages added at
+// runtime) as YAML or JSON, keys of objects are in sorted order so output is stable
+func Export(lang string, format ExportFormat, opts ...ExportOpts) ([]byte, error) {
+	if !status.Initialized {
+		return nil, errors.ErrorNotInitialized
+	}
+	if format != ExportYAML && format != ExportJSON {
+		return nil, fmt.Errorf("unsupported export format [%s]", format)
+	}
+
+	var exportOpts ExportOpts
+	if len(opts) > 0 {
+		exportOpts = opts[0]
+	}
+	languages := []string{lang}
+	if exportOpts.Fallback {
+		for _, fbLang := range GetFallbackChain() {
+			if !slices.Contains(languages, fbLang) {
+				languages = append(languages, fbLang)
+			}
+		}
+	}
+
+	result := make(map[string]any)
+	for idx, language := range languages {
+		values, err := collectValues(language)
+		if err != nil {
+			if idx == 0 {
+				return nil, err
+			}
+			// fallback language may be unloaded meanwhile
+			continue
+		}
+		for path, pathValue := range values {
+			if !canFill(result, pathValue.keys) {
+				continue
+			}
+			value := pathValue.value
+			if _, ok := value.(string); ok && exportOpts.ExpandTemplates {
+				if expanded, err := i18nFS.GetValByPath(language, path); err == nil {
+					value = expanded
+				}
+			}
+			utils.SetStringMap(result, pathValue.keys, value)
+		}
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case ExportJSON:
+		encoder := json.NewEncoder(&buf)
+		encoder.SetIndent("", "  ")
+		// messages may contain html
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(result); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(result); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+}
+
+// canFill check result doesn't have keys, nor a value (not object) on the way of it
+func canFill(result map[string]any, keys []string) bool {
+	for n := 1; n <= len(keys); n++ {
+		value, ok := utils.GetStringMap(result, keys[:n])
+		if !ok {
+			return true
+		}
+		if _, isMap := value.(map[string]any); !isMap {
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"github.com/hanakogo/i18n/internal/utils"
+	"slices"
+	"strings"
+)
+
+// FormatMismatch printf verbs or named placeholders of a message are incompatible with the message of default
+// language, so the same arguments can't be passed to GetStringTrF or GetMessageTr of both languages
+type FormatMismatch struct {
+	Path       string
+	Lang       string
+	Format     string
+	BaseLang   string
+	BaseFormat string
+	Reason     string
+}
+
+// FormatReport mismatches keyed by path, then by language
+type FormatReport map[string]map[string]FormatMismatch
+
+// Mismatches all mismatches sorted by path and language
+func (r FormatReport) Mismatches() (mismatches []FormatMismatch) {
+	for _, languages := range r {
+		for _, mismatch := range languages {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+	slices.SortFunc(mismatches, func(a, b FormatMismatch) int {
+		if a.Path != b.Path {
+			return strings.Compare(a.Path, b.Path)
+		}
+		return strings.Compare(a.Lang, b.Lang)
+	})
+	return
+}
+
+// FormatCheckError is returned by Init if Opts.CheckFormats is enabled and any message is incompatible,
+// package isn't initialized then
+type FormatCheckError struct {
+	Report FormatReport
+}
+
+func (e *FormatCheckError) Error() string {
+	var lines []string
+	for _, mismatch := range e.Report.Mismatches() {
+		lines = append(lines, fmt.Sprintf("path [%s] of language [%s]: %s", mismatch.Path, mismatch.Lang, mismatch.Reason))
+	}
+	return fmt.Sprintf("printf verbs or placeholders of %d message(s) are incompatible with default language:\n%s",
+		len(lines), strings.Join(lines, "\n"))
+}
+
+// CheckFormats compare printf verbs and named placeholders of string values in all loaded languages with default
+// language. arguments are matched by index, so explicit indexes like "%[2]s" can reorder them, and verbs of the same
+// argument must accept the same kind of value, e.g. "%d" and "%s" are incompatible. named placeholders are matched by
+// name, a translation must use the same names, and a name must have the same type like "number" or "date"
+func CheckFormats() (FormatReport, error) {
+	if !status.Initialized {
+		return nil, errors.ErrorNotInitialized
+	}
+
+	defLang := GetDefaultLang()
+	baseValues, err := collectValues(defLang)
+	if err != nil {
+		return nil, err
+	}
+	report := make(FormatReport)
+	for _, lang := range i18nFS.GetLanguages() {
+		if lang == defLang {
+			continue
+		}
+		values, err := collectValues(lang)
+		if err != nil {
+			return nil, err
+		}
+		for path, baseValue := range baseValues {
+			baseString, ok := baseValue.value.(string)
+			if !ok {
+				continue
+			}
+			stringVal, ok := values[path].value.(string)
+			if !ok {
+				continue
+			}
+			err := utils.CompareFormats(baseString, stringVal)
+			if err == nil {
+				continue
+			}
+			if report[path] == nil {
+				report[path] = make(map[string]FormatMismatch)
+			}
+			report[path][lang] = FormatMismatch{
+				Path:       path,
+				Lang:       lang,
+				Format:     stringVal,
+				BaseLang:   defLang,
+				BaseFormat: baseString,
+				Reason:     err.Error(),
+			}
+		}
+	}
+	return report, nil
+}
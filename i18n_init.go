@@ -1,17 +1,18 @@
 package i18n
 
 import (
+	"cmp"
+	"fmt"
 	"github.com/hanakogo/i18n/internal/errors"
 	"github.com/hanakogo/i18n/internal/status"
 	"github.com/hanakogo/i18n/internal/structs"
+	"slices"
 )
 
 var i18nFS *structs.I18nFS
-var (
-	DefaultLang  string
-	FallbackLang string
-)
 
+// Init read catalog and set default and fallback languages, package is reset if any error is returned
+// (include *FormatCheckError), so Init can be called again
 func Init(opts Opts) (err error) {
 	if status.Initialized {
 		return errors.ErrorAlreadyInitialized
@@ -22,17 +23,60 @@ func Init(opts Opts) (err error) {
 		return err
 	}
 	status.Initialized = true
+	defer func() {
+		if err != nil {
+			Reset()
+		}
+	}()
+	localizedPrintf.Store(opts.LocalizedPrintf)
 
-	err = initLoadLanguages(opts.Languages)
+	languages := opts.Languages
+	if opts.DiscoverLanguages {
+		discovered, err := DiscoverLanguages()
+		if err != nil {
+			return err
+		}
+		for _, language := range discovered {
+			if !slices.Contains(languages, language) {
+				languages = append(slices.Clip(languages), language)
+			}
+		}
+	}
+	if opts.Lazy {
+		// other languages are read on first lookup
+		languages = slices.Compact([]string{opts.DefaultLang, opts.FallbackLang})
+	}
+	err = initLoadLanguages(languages)
 	if err != nil {
 		return err
 	}
 
+	for _, pseudo := range opts.PseudoLocales {
+		err = loadPseudoLocale(pseudo, opts.DefaultLang)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = initSetDefault(opts.DefaultLang, opts.FallbackLang)
 	if err != nil {
 		return err
 	}
 
+	if opts.Lazy && opts.IdleTimeout > 0 {
+		startEviction(opts.IdleTimeout)
+	}
+
+	if opts.CheckFormats {
+		report, err := CheckFormats()
+		if err != nil {
+			return err
+		}
+		if len(report) > 0 {
+			return &FormatCheckError{Report: report}
+		}
+	}
+
 	return nil
 }
 
@@ -42,6 +86,28 @@ func initI18nFS(opts Opts) (err error) {
 		opts.FSOpts.Prefix,
 		opts.FSOpts.EmbedFS,
 	)
+	if err != nil {
+		return
+	}
+	i18nFS.Name = cmp.Or(opts.FSOpts.Name, "base")
+	i18nFS.ConflictPolicy = opts.ConflictPolicy
+	i18nFS.Namespaced = opts.FSOpts.Namespaced
+	i18nFS.CommonLang = opts.CommonLang
+	i18nFS.Lazy = opts.Lazy
+	for idx, overlay := range opts.Overlays {
+		err = i18nFS.AddOverlay(
+			cmp.Or(overlay.Name, fmt.Sprintf("overlay%d", idx+1)),
+			overlay.FSMode,
+			overlay.Prefix,
+			overlay.EmbedFS,
+		)
+		if err != nil {
+			return
+		}
+	}
+	if opts.CommonLang != "" {
+		err = i18nFS.ReadCommon()
+	}
 	return
 }
 
@@ -56,17 +122,15 @@ func initLoadLanguages(languages []string) error {
 }
 
 func initSetDefault(defLang string, fbLang string) (err error) {
-	if Has(fbLang) {
-		FallbackLang = fbLang
-	} else {
+	if !Has(fbLang) {
 		return errors.GetSpecificTypeLangNotFound("fallback", fbLang)
 	}
-
-	if Has(defLang) {
-		DefaultLang = defLang
-	} else {
-		return errors.GetSpecificTypeLangNotFound("default", fbLang)
+	if !Has(defLang) {
+		return errors.GetSpecificTypeLangNotFound("default", defLang)
 	}
+	updateLangs(func(*langState) *langState {
+		return newLangState(defLang, []string{fbLang})
+	}, false)
 	return
 }
 
@@ -79,5 +143,9 @@ func Reset() {
 		return
 	}
 	status.Initialized = false
+	stopEviction()
 	i18nFS = nil
+	resetLangs()
+	resetPseudoLocales()
+	localizedPrintf.Store(false)
 }
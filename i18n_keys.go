@@ -0,0 +1,58 @@
+package i18n
+
+import (
+	"github.com/hanakogo/i18n/internal/status"
+	"slices"
+)
+
+// Keys list full paths of all values under prefix in sorted order, list all values of language if prefix is empty
+func Keys(lang string, prefix string) []string {
+	status.MustInitialized()
+
+	return collectPaths([]string{lang}, func(lang string) ([]string, error) {
+		return i18nFS.Keys(lang, prefix)
+	})
+}
+
+// KeysWithFallback same as Keys, but union with keys of languages of fallback chain
+func KeysWithFallback(lang string, prefix string) []string {
+	status.MustInitialized()
+
+	return collectPaths(append([]string{lang}, GetFallbackChain()...), func(lang string) ([]string, error) {
+		return i18nFS.Keys(lang, prefix)
+	})
+}
+
+// Find list full paths of all values and objects which match pattern in sorted order,
+// e.g. "tips.*" lists every tip object, "tips.*.title" lists title of them, "**.title" matches at any depth
+func Find(lang string, pattern string) []string {
+	status.MustInitialized()
+
+	return collectPaths([]string{lang}, func(lang string) ([]string, error) {
+		return i18nFS.Find(lang, pattern)
+	})
+}
+
+// FindWithFallback same as Find, but union with paths of languages of fallback chain
+func FindWithFallback(lang string, pattern string) []string {
+	status.MustInitialized()
+
+	return collectPaths(append([]string{lang}, GetFallbackChain()...), func(lang string) ([]string, error) {
+		return i18nFS.Find(lang, pattern)
+	})
+}
+
+// collectPaths union paths of all languages, languages which failed are skipped
+func collectPaths(languages []string, listFunc func(lang string) ([]string, error)) []string {
+	var paths []string
+	for _, language := range languages {
+		langPaths, err := listFunc(language)
+		if err != nil {
+			continue
+		}
+		paths = append(paths, langPaths...)
+	}
+
+	slices.Sort(paths)
+	return slices.Compact(paths)
+}
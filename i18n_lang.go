@@ -0,0 +1,196 @@
+package i18n
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// langState languages used by functions without language parameter, it's replaced as a whole and never modified,
+// so readers always see a consistent default language and fallback chain
+type langState struct {
+	defaultLang   string
+	fallbackChain []string
+	// lookup default language followed by fallback chain without default language
+	lookup []string
+}
+
+// newLangState duplicates of fallbackChain are removed
+func newLangState(defLang string, fallbackChain []string) *langState {
+	state := &langState{defaultLang: defLang, lookup: []string{defLang}}
+	for _, lang := range fallbackChain {
+		if slices.Contains(state.fallbackChain, lang) {
+			continue
+		}
+		state.fallbackChain = append(state.fallbackChain, lang)
+		if lang != defLang {
+			state.lookup = append(state.lookup, lang)
+		}
+	}
+	return state
+}
+
+var currentLangs atomic.Pointer[langState]
+
+// DefaultLang and FallbackLang are written without synchronization, only by Init, Reset, SetDefaultLang,
+// SetFallbackLang, SetFallbackChain and Unload (never in background, e.g. by eviction of lazy mode). they are not safe
+// to read concurrently with these functions, use GetDefaultLang and GetFallbackLang in concurrent code
+var (
+	// Deprecated: use GetDefaultLang and SetDefaultLang. it mirrors the default language, assigning it has no effect
+	DefaultLang string
+	// Deprecated: use GetFallbackLang and SetFallbackLang. it mirrors the first language of fallback chain,
+	// assigning it has no effect
+	FallbackLang string
+)
+
+// langUpdates serializes updates of currentLangs, subscribers are notified while holding it,
+// so they see changes in the same order as they're made
+var langUpdates struct {
+	sync.Mutex
+	nextID      int
+	subscribers map[int]func(LangChange)
+}
+
+// LangChange is passed to subscribers of OnLangChange after the default language or fallback chain is changed
+type LangChange struct {
+	OldDefaultLang   string
+	DefaultLang      string
+	OldFallbackChain []string
+	FallbackChain    []string
+}
+
+func getLangs() *langState {
+	if state := currentLangs.Load(); state != nil {
+		return state
+	}
+	return &langState{}
+}
+
+// GetDefaultLang get the language used by functions without language parameter
+func GetDefaultLang() string {
+	return getLangs().defaultLang
+}
+
+// GetFallbackLang get the first language of fallback chain
+func GetFallbackLang() string {
+	chain := getLangs().fallbackChain
+	if len(chain) == 0 {
+		return ""
+	}
+	return chain[0]
+}
+
+// GetFallbackChain get languages which are tried in order if a path isn't found in the default language
+func GetFallbackChain() []string {
+	return slices.Clone(getLangs().fallbackChain)
+}
+
+// SetDefaultLang change the default language, it must be loaded (it's loaded first in lazy mode)
+func SetDefaultLang(lang string) error {
+	if err := checkLang("default", lang); err != nil {
+		return err
+	}
+	updateLangs(func(old *langState) *langState {
+		return newLangState(lang, old.fallbackChain)
+	}, true)
+	return nil
+}
+
+// SetFallbackLang change the fallback chain to a single language, see SetFallbackChain
+func SetFallbackLang(lang string) error {
+	return SetFallbackChain(lang)
+}
+
+// SetFallbackChain change languages which are tried in order if a path isn't found in the default language,
+// e.g. "zh-TW", "zh-CN", "en". all of them must be loaded (they're loaded first in lazy mode)
+func SetFallbackChain(languages ...string) error {
+	if len(languages) == 0 {
+		return fmt.Errorf("fallback chain is empty")
+	}
+	for _, lang := range languages {
+		if err := checkLang("fallback", lang); err != nil {
+			return err
+		}
+	}
+	updateLangs(func(old *langState) *langState {
+		return newLangState(old.defaultLang, languages)
+	}, true)
+	return nil
+}
+
+// OnLangChange register fn which is called after the default language or fallback chain is changed by
+// SetDefaultLang, SetFallbackLang, SetFallbackChain or Unload, it isn't called by Init.
+// fn is called synchronously in order of changes, so it must not change them again.
+// subscribers are removed by Reset
+func OnLangChange(fn func(LangChange)) (unsubscribe func()) {
+	langUpdates.Lock()
+	defer langUpdates.Unlock()
+	if langUpdates.subscribers == nil {
+		langUpdates.subscribers = make(map[int]func(LangChange))
+	}
+	id := langUpdates.nextID
+	langUpdates.nextID++
+	langUpdates.subscribers[id] = fn
+	return func() {
+		langUpdates.Lock()
+		defer langUpdates.Unlock()
+		delete(langUpdates.subscribers, id)
+	}
+}
+
+// checkLang check language of typ ("default" or "fallback") is loaded
+func checkLang(typ string, lang string) error {
+	if !status.Initialized {
+		return errors.ErrorNotInitialized
+	}
+	if i18nFS.Lazy && !i18nFS.HasLang(lang) && i18nFS.IsLangExists(lang) {
+		if err := i18nFS.Read(lang); err != nil {
+			return err
+		}
+	}
+	if !i18nFS.HasLang(lang) {
+		return errors.GetSpecificTypeLangNotFound(typ, lang)
+	}
+	return nil
+}
+
+// updateLangs replace languages by result of update, subscribers are notified if notify is true
+func updateLangs(update func(old *langState) *langState, notify bool) {
+	langUpdates.Lock()
+	defer langUpdates.Unlock()
+
+	old := getLangs()
+	state := update(old)
+	currentLangs.Store(state)
+	// deprecated mirrors, they're not synchronized, see DefaultLang
+	DefaultLang, FallbackLang = GetDefaultLang(), GetFallbackLang()
+	if !notify || old.defaultLang == state.defaultLang && slices.Equal(old.fallbackChain, state.fallbackChain) {
+		return
+	}
+
+	ids := make([]int, 0, len(langUpdates.subscribers))
+	for id := range langUpdates.subscribers {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		langUpdates.subscribers[id](LangChange{
+			OldDefaultLang:   old.defaultLang,
+			DefaultLang:      state.defaultLang,
+			OldFallbackChain: slices.Clone(old.fallbackChain),
+			FallbackChain:    slices.Clone(state.fallbackChain),
+		})
+	}
+}
+
+// resetLangs clear languages and subscribers
+func resetLangs() {
+	langUpdates.Lock()
+	defer langUpdates.Unlock()
+	currentLangs.Store(nil)
+	DefaultLang, FallbackLang = "", ""
+	langUpdates.subscribers = nil
+}
@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"github.com/hanakogo/i18n/internal/status"
+	"sync"
+	"time"
+)
+
+var eviction struct {
+	sync.Mutex
+	idle time.Duration
+	stop chan struct{}
+}
+
+// startEviction evict idle languages periodically until Reset
+func startEviction(idle time.Duration) {
+	eviction.Lock()
+	defer eviction.Unlock()
+	eviction.idle = idle
+	eviction.stop = make(chan struct{})
+
+	// the goroutine must not read i18nFS which is replaced by Reset
+	fs, stop := i18nFS, eviction.stop
+	go func() {
+		ticker := time.NewTicker(idle)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				fs.EvictIdle(idle, getLangs().lookup...)
+			}
+		}
+	}()
+}
+
+func stopEviction() {
+	eviction.Lock()
+	defer eviction.Unlock()
+	if eviction.stop != nil {
+		close(eviction.stop)
+		eviction.stop = nil
+	}
+	eviction.idle = 0
+}
+
+// EvictIdle unload languages which aren't looked up for Opts.IdleTimeout now instead of waiting for the next
+// periodic eviction, and returns them. the default language, languages of fallback chain and languages which
+// only have runtime messages are kept. nothing is evicted unless Opts.Lazy and Opts.IdleTimeout are set
+func EvictIdle() []string {
+	status.MustInitialized()
+
+	eviction.Lock()
+	idle := eviction.idle
+	eviction.Unlock()
+	if idle <= 0 {
+		return nil
+	}
+	return i18nFS.EvictIdle(idle, getLangs().lookup...)
+}
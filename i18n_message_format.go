@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/utils"
+)
+
+// Args named arguments of placeholders in message
+type Args map[string]any
+
+// placeholderFormatter format value of placeholder with style for language, ok is false if value or style is invalid
+type placeholderFormatter func(lang string, value any, style string) (result string, ok bool)
+
+// placeholderFormatters formatters keyed by type of placeholder
+var placeholderFormatters = map[string]placeholderFormatter{
+	"number": func(lang string, value any, style string) (string, bool) {
+		opts, ok := parseNumberStyle(style)
+		if !ok || !isNumber(value) {
+			return "", false
+		}
+		return FormatNumber(lang, value, opts), true
+	},
+	"date":     dateFormatter(FormatDate),
+	"time":     dateFormatter(FormatTime),
+	"datetime": dateFormatter(FormatDateTime),
+	"currency": func(lang string, value any, style string) (string, bool) {
+		code, opts, ok := parseCurrencyStyle(style)
+		if !ok {
+			return "", false
+		}
+		result, err := FormatCurrency(lang, value, code, opts)
+		return result, err == nil
+	},
+}
+
+// FormatMessage replace placeholders of message with args formatted for language.
+// placeholder is like "{name}" or "{name, type, style}", types are:
+//
+//	number  "{n, number}", style can be "integer", "percent", "compact", "scientific" or fraction digits like ".00"
+//	date    "{t, date, medium}", style can be "short", "medium", "long", "full" or a skeleton like "::yMMMd"
+//	time    "{t, time, short}", styles are the same as date
+//	datetime  "{t, datetime, long}", date and time combined, styles are the same as date
+//	currency  "{price, currency, EUR}", code of currency can be followed by "narrow", "code" and "minor" (value is
+//	          in minor units) like "{cents, currency, USD minor}"
+//
+// numbers of "{name}" are formatted as "{name, number}". placeholders whose argument is missing, style is invalid or
+// language has no data of it (e.g. dates of a language which isn't in DateLanguages) are kept as they are
+func FormatMessage(lang string, message string, args Args) string {
+	return utils.ReplacePlaceholders(message, func(placeholder utils.Placeholder) (string, bool) {
+		value, ok := args[placeholder.Name]
+		if !ok {
+			return "", false
+		}
+		typ := placeholder.Type
+		if typ == "" {
+			if !isNumber(value) {
+				return fmt.Sprint(value), true
+			}
+			typ = "number"
+		}
+		formatter, ok := placeholderFormatters[typ]
+		if !ok {
+			return "", false
+		}
+		return formatter(lang, value, placeholder.Style)
+	})
+}
+
+// GetMessageTr get string of path in language and replace placeholders of it with args, see FormatMessage
+func GetMessageTr(lang string, path string, args Args) string {
+	message := GetStringTr(lang, path, DefaultString)
+	if message == DefaultString {
+		return message
+	}
+	return FormatMessage(lang, message, args)
+}
+
+// GetMessage same as GetMessageTr, but fallback to languages of fallback chain,
+// args are formatted for the language which message is found in
+func GetMessage(path string, args Args) string {
+	for _, lang := range getLangs().lookup {
+		if message := GetMessageTr(lang, path, args); message != DefaultString {
+			return message
+		}
+	}
+	return DefaultString
+}
@@ -0,0 +1,23 @@
+package i18n
+
+import (
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+)
+
+// AddMessages merge messages into language at runtime, they're above values of all sources, so they take part in
+// fallback and template strings like values of files. keys of messages are paths like "plugin.title",
+// values of type map[string]any are merged deeply. language which has no directory is loaded by it.
+// messages are merged atomically, lookups never see a half of them
+func AddMessages(lang string, messages map[string]any) error {
+	if !status.Initialized {
+		return errors.ErrorNotInitialized
+	}
+
+	return i18nFS.AddMessages(lang, messages)
+}
+
+// SetMessage set value of path in language at runtime, see AddMessages
+func SetMessage(lang string, path string, value any) error {
+	return AddMessages(lang, map[string]any{path: value})
+}
@@ -0,0 +1,168 @@
+package i18n
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+	"math"
+	"strings"
+)
+
+type NumberStyle int
+
+const (
+	// NumberDecimal grouped decimal like "1,234,567.5"
+	NumberDecimal NumberStyle = iota
+	// NumberPercent value multiplied by 100 like "26%"
+	NumberPercent
+	// NumberCompact value scaled by thousands with suffix like "1.2K", "3.5M", suffixes are English for all languages
+	NumberCompact
+	// NumberScientific like "1.23 × 10⁶"
+	NumberScientific
+)
+
+// NoFractionDigits value of NumberOpts.MaxFractionDigits to round to integer
+const NoFractionDigits = -1
+
+type NumberOpts struct {
+	Style NumberStyle
+	// MinFractionDigits fraction is padded with zeros to it
+	MinFractionDigits int
+	// MaxFractionDigits fraction is rounded to it, zero means default of style (3 for decimal, 0 for percent and
+	// scientific, 1 for compact), use NoFractionDigits to round to integer
+	MaxFractionDigits int
+	// Digits numbering system like "arab" (Arabic-Indic) or "deva" (Devanagari), default is of language
+	Digits string
+}
+
+// compactUnits suffixes of NumberCompact from small to large
+var compactUnits = []struct {
+	scale  float64
+	suffix string
+}{
+	{1, ""},
+	{1e3, "K"},
+	{1e6, "M"},
+	{1e9, "B"},
+	{1e12, "T"},
+}
+
+// FormatNumber format number for language with grouping, decimal separator and digits of it,
+// e.g. 1234567.5 is "1,234,567.5" in "en" and "1.234.567,5" in "de". values which aren't numbers are formatted by
+// fmt.Sprint
+func FormatNumber(lang string, value any, opts ...NumberOpts) string {
+	var numberOpts NumberOpts
+	if len(opts) > 0 {
+		numberOpts = opts[0]
+	}
+	if !isNumber(value) {
+		return fmt.Sprint(value)
+	}
+
+	tag := langTag(lang)
+	if numberOpts.Digits != "" {
+		if digitsTag, err := tag.SetTypeForKey("nu", numberOpts.Digits); err == nil {
+			tag = digitsTag
+		}
+	}
+	printer := printerOf(tag)
+
+	var options []number.Option
+	if numberOpts.MinFractionDigits > 0 {
+		options = append(options, number.MinFractionDigits(numberOpts.MinFractionDigits))
+	}
+	maxFractionDigits := numberOpts.MaxFractionDigits
+	if maxFractionDigits == 0 && numberOpts.Style == NumberCompact {
+		maxFractionDigits = 1
+	}
+	if maxFractionDigits != 0 {
+		options = append(options, number.MaxFractionDigits(max(maxFractionDigits, 0)))
+	}
+
+	switch numberOpts.Style {
+	case NumberPercent:
+		return printer.Sprint(number.Percent(value, options...))
+	case NumberScientific:
+		return printer.Sprint(number.Scientific(value, options...))
+	case NumberCompact:
+		floatVal := ConvertAnyToFloat(value)
+		pow := math.Pow10(max(maxFractionDigits, 0))
+		for idx, unit := range compactUnits {
+			if idx+1 < len(compactUnits) && math.Abs(floatVal) >= compactUnits[idx+1].scale {
+				continue
+			}
+			// value is rounded first, e.g. 999999 is "1M" rather than "1,000K"
+			rounded := math.Round(floatVal/unit.scale*pow) / pow
+			if math.Abs(rounded) >= 1000 && idx+1 < len(compactUnits) {
+				unit = compactUnits[idx+1]
+			}
+			if unit.scale == 1 {
+				return printer.Sprint(number.Decimal(value, options...))
+			}
+			return printer.Sprint(number.Decimal(floatVal/unit.scale, options...)) + unit.suffix
+		}
+		return printer.Sprint(number.Decimal(value, options...))
+	default:
+		return printer.Sprint(number.Decimal(value, options...))
+	}
+}
+
+// parseNumberStyle parse style of placeholder like "{n, number, percent}", style can be "integer", "percent",
+// "compact", "scientific" or fraction digits like ".00" (exactly 2) and ".0#" (1 to 2)
+func parseNumberStyle(style string) (opts NumberOpts, ok bool) {
+	switch style {
+	case "":
+		return opts, true
+	case "integer":
+		opts.MaxFractionDigits = NoFractionDigits
+		return opts, true
+	case "percent":
+		opts.Style = NumberPercent
+		return opts, true
+	case "compact":
+		opts.Style = NumberCompact
+		return opts, true
+	case "scientific":
+		opts.Style = NumberScientific
+		return opts, true
+	}
+
+	fraction, found := strings.CutPrefix(style, ".")
+	if !found {
+		return opts, false
+	}
+	opts.MinFractionDigits = len(fraction) - len(strings.TrimLeft(fraction, "0"))
+	if strings.Trim(fraction[opts.MinFractionDigits:], "#") != "" {
+		return opts, false
+	}
+	opts.MaxFractionDigits = len(fraction)
+	if opts.MaxFractionDigits == 0 {
+		opts.MaxFractionDigits = NoFractionDigits
+	}
+	return opts, true
+}
+
+// langTag tag of language, languages which can't be parsed are treated as undetermined
+func langTag(lang string) language.Tag {
+	tag, _ := language.Parse(lang)
+	return tag
+}
+
+// langCandidates language with region (if tag has it) and language of tag, e.g. "de-CH" and "de" of "de-CH-u-nu-latn"
+func langCandidates(tag language.Tag) []string {
+	base, _ := tag.Base()
+	var candidates []string
+	if region, confidence := tag.Region(); confidence == language.Exact {
+		candidates = append(candidates, base.String()+"-"+region.String())
+	}
+	return append(candidates, base.String())
+}
+
+func isNumber(value any) bool {
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -3,6 +3,7 @@ package i18n
 import (
 	"embed"
 	"github.com/hanakogo/i18n/i18nfs"
+	"time"
 )
 
 type Opts struct {
@@ -10,10 +11,40 @@ type Opts struct {
 	DefaultLang  string
 	FallbackLang string
 	Languages    []string
+	// DiscoverLanguages load every directory under prefix of all sources as a language besides Languages,
+	// directory names must be valid BCP 47 language tags, see DiscoverLanguages
+	DiscoverLanguages bool
+	// CommonLang directory of the shared layer (e.g. "common"), its values are visible to every language
+	// beneath values of the language itself, see Origin
+	CommonLang string
+	// CheckFormats make Init fail with *FormatCheckError if printf verbs or placeholders of any message are incompatible
+	// with DefaultLang, see CheckFormats
+	CheckFormats bool
+	// ConflictPolicy how paths defined by more than one file of a language are merged, see Overrides
+	ConflictPolicy i18nfs.ConflictPolicy
+	// Overlays sources stacked on FSOpts, values of later sources override earlier ones per path,
+	// a language only needs to exist in one of sources, see Source. Namespaced of overlays is the same as FSOpts
+	Overlays []FSOpts
+	// LocalizedPrintf format strings of GetStringF and GetStringTrF by Printer of language, so numbers of "%d" and
+	// "%v" get grouping and digits of language, e.g. "1.234.567" in "de"
+	LocalizedPrintf bool
+	// PseudoLocales languages generated at Init, Source of them is DefaultLang by default, see PseudoLocale
+	PseudoLocales []PseudoLocale
+	// Lazy only DefaultLang and FallbackLang are read by Init, other languages which exist in sources are read
+	// on their first lookup, Languages is ignored
+	Lazy bool
+	// IdleTimeout languages which aren't looked up for it are unloaded in lazy mode (except the default language
+	// and languages of fallback chain), they're read again on next lookup. zero means never
+	IdleTimeout time.Duration
 }
 
 type FSOpts struct {
+	// Name name of source reported by Source, default is "base" for Opts.FSOpts and "overlay<n>" for Opts.Overlays
+	Name    string
 	FSMode  i18nfs.FSMode
 	Prefix  string
 	EmbedFS *embed.FS
+	// Namespaced put values of each file under keys of its path relative to directory of language,
+	// e.g. values of "lang/en/billing/invoice.yaml" are got by "billing.invoice.*"
+	Namespaced bool
 }
@@ -0,0 +1,42 @@
+package i18n
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+	"sync"
+	"sync/atomic"
+)
+
+// localizedPrintf format strings of GetStringF and GetStringTrF by Printer, see Opts.LocalizedPrintf
+var localizedPrintf atomic.Bool
+
+// printers cached printers keyed by language tag, a printer is safe for concurrent use
+var printers sync.Map
+
+// emptyCatalog printers don't translate format strings, messages are translated by catalog of this package
+var emptyCatalog = catalog.NewBuilder()
+
+// Printer get printer of language which formats numbers of "%d", "%v" and so on with grouping and digits of language,
+// e.g. Printer("de").Sprintf("%d", 1234567) is "1.234.567". printers are cached, so it's cheap to call it per format
+func Printer(lang string) *message.Printer {
+	return printerOf(langTag(lang))
+}
+
+func printerOf(tag language.Tag) *message.Printer {
+	key := tag.String()
+	if printer, ok := printers.Load(key); ok {
+		return printer.(*message.Printer)
+	}
+	printer, _ := printers.LoadOrStore(key, message.NewPrinter(tag, message.Catalog(emptyCatalog)))
+	return printer.(*message.Printer)
+}
+
+// sprintf format string of language by its Printer if Opts.LocalizedPrintf is enabled, otherwise by fmt
+func sprintf(lang string, format string, args ...any) string {
+	if localizedPrintf.Load() {
+		return Printer(lang).Sprintf(format, args...)
+	}
+	return fmt.Sprintf(format, args...)
+}
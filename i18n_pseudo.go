@@ -0,0 +1,78 @@
+package i18n
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"github.com/hanakogo/i18n/internal/utils"
+	"sync"
+)
+
+// PseudoLocale a language generated from another one to spot hard-coded strings and layout problems,
+// e.g. "Hello, ${user.name}! %d new" becomes "[Ĥéļļö, ${user.name}! %d ñéŵ ~~~~]".
+// letters are accented, strings are expanded and wrapped by brackets, templates, placeholders and printf verbs are kept
+type PseudoLocale struct {
+	// Lang name of pseudo-locale, e.g. "en-XA", or "ar-XB" with Mirror
+	Lang string
+	// Source language which values are generated from, default is the default language
+	Source string
+	// Expansion ratio of extra length, e.g. 0.3 makes text 30% longer by appending "~", zero doesn't expand
+	Expansion float64
+	// Mirror display text from right to left by bidi override, for testing layouts of RTL languages
+	Mirror bool
+}
+
+// pseudoLocales pseudo-locales which are loaded, keyed by language, they're generated again by Reload
+var pseudoLocales sync.Map
+
+// LoadPseudoLocale generate pseudo-locale from merged values of its source, values of the common layer are
+// generated too. it's generated again if it's loaded already, e.g. after source is reloaded
+func LoadPseudoLocale(pseudo PseudoLocale) error {
+	if !status.Initialized {
+		return errors.ErrorNotInitialized
+	}
+
+	return loadPseudoLocale(pseudo, GetDefaultLang())
+}
+
+func loadPseudoLocale(pseudo PseudoLocale, defLang string) error {
+	pseudo.Source = cmp.Or(pseudo.Source, defLang)
+	if pseudo.Lang == pseudo.Source {
+		return fmt.Errorf("pseudo-locale [%s] can't be generated from itself", pseudo.Lang)
+	}
+	if i18nFS.IsLangExists(pseudo.Lang) {
+		return fmt.Errorf("pseudo-locale [%s] has a directory in sources", pseudo.Lang)
+	}
+	values, err := collectValues(pseudo.Source)
+	if err != nil {
+		return err
+	}
+
+	pseudoOpts := utils.PseudoOpts{Expansion: pseudo.Expansion, Mirror: pseudo.Mirror}
+	langMap := make(map[string]any)
+	for _, value := range values {
+		utils.SetStringMap(langMap, value.keys, utils.Pseudo(value.value, pseudoOpts))
+	}
+	if err = i18nFS.LoadValues(pseudo.Lang, langMap); err != nil {
+		return err
+	}
+	pseudoLocales.Store(pseudo.Lang, pseudo)
+	return nil
+}
+
+// reloadPseudoLocale generate pseudo-locale again if lang is one of them
+func reloadPseudoLocale(lang string) (ok bool, err error) {
+	pseudo, ok := pseudoLocales.Load(lang)
+	if !ok {
+		return false, nil
+	}
+	return true, loadPseudoLocale(pseudo.(PseudoLocale), "")
+}
+
+func resetPseudoLocales() {
+	pseudoLocales.Range(func(lang, _ any) bool {
+		pseudoLocales.Delete(lang)
+		return true
+	})
+}
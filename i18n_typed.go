@@ -0,0 +1,106 @@
+package i18n
+
+import (
+	"math/big"
+	"time"
+)
+
+// getTrE get value from specified language and convert it,
+// ok is false if the path doesn't exist or the value can't be converted
+func getTrE[T any](lang string, path string, convertFunc ConvertFuncE[T]) (val T, ok bool) {
+	value := getAnyOfLang(lang, path)
+	//goland:noinspection GoTypeAssertionOnErrors
+	if _, isErr := value.(error); isErr || value == nil {
+		return val, false
+	}
+
+	val, err := convertFunc(value)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// getE same as getTrE, but try languages of fallback chain in order if failed on default language
+func getE[T any](path string, convertFunc ConvertFuncE[T]) (val T, ok bool) {
+	for _, lang := range getLangs().lookup {
+		if val, ok = getTrE[T](lang, path, convertFunc); ok {
+			return
+		}
+	}
+
+	return
+}
+
+func GetBoolTr(lang string, path string, def ...bool) bool {
+	if boolVal, ok := getTrE[bool](lang, path, ConvertAnyToBoolE); ok {
+		return boolVal
+	}
+	return requireDefault[bool](DefaultBool, def...)
+}
+
+func GetBool(path string, def ...bool) bool {
+	if boolVal, ok := getE[bool](path, ConvertAnyToBoolE); ok {
+		return boolVal
+	}
+	return requireDefault[bool](DefaultBool, def...)
+}
+
+func GetUint64Tr(lang string, path string, def ...uint64) uint64 {
+	if uintVal, ok := getTrE[uint64](lang, path, ConvertAnyToUint64E); ok {
+		return uintVal
+	}
+	return requireDefault[uint64](DefaultUint, def...)
+}
+
+func GetUint64(path string, def ...uint64) uint64 {
+	if uintVal, ok := getE[uint64](path, ConvertAnyToUint64E); ok {
+		return uintVal
+	}
+	return requireDefault[uint64](DefaultUint, def...)
+}
+
+func GetDurationTr(lang string, path string, def ...time.Duration) time.Duration {
+	if durationVal, ok := getTrE[time.Duration](lang, path, ConvertAnyToDurationE); ok {
+		return durationVal
+	}
+	return requireDefault[time.Duration](DefaultDuration, def...)
+}
+
+func GetDuration(path string, def ...time.Duration) time.Duration {
+	if durationVal, ok := getE[time.Duration](path, ConvertAnyToDurationE); ok {
+		return durationVal
+	}
+	return requireDefault[time.Duration](DefaultDuration, def...)
+}
+
+// GetTimeTr parse string value with layout, YAML timestamps are accepted whatever layout is
+func GetTimeTr(lang string, path string, layout string, def ...time.Time) time.Time {
+	if timeVal, ok := getTrE[time.Time](lang, path, ConvertTimeLayout(layout)); ok {
+		return timeVal
+	}
+	return requireDefault[time.Time](DefaultTime, def...)
+}
+
+func GetTime(path string, layout string, def ...time.Time) time.Time {
+	if timeVal, ok := getE[time.Time](path, ConvertTimeLayout(layout)); ok {
+		return timeVal
+	}
+	return requireDefault[time.Time](DefaultTime, def...)
+}
+
+// GetBigFloatTr get value as *big.Float, quote the number in YAML to keep all digits of it
+func GetBigFloatTr(lang string, path string, def ...*big.Float) *big.Float {
+	if bigFloatVal, ok := getTrE[*big.Float](lang, path, ConvertAnyToBigFloatE); ok {
+		return bigFloatVal
+	}
+	return requireDefault[*big.Float](DefaultBigFloat, def...)
+}
+
+func GetBigFloat(path string, def ...*big.Float) *big.Float {
+	if bigFloatVal, ok := getE[*big.Float](path, ConvertAnyToBigFloatE); ok {
+		return bigFloatVal
+	}
+	return requireDefault[*big.Float](DefaultBigFloat, def...)
+}
@@ -0,0 +1,77 @@
+package i18n
+
+import (
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/status"
+	"slices"
+)
+
+type UnloadOpts struct {
+	// DefaultLang replacement of the default language, it's required if the language to unload is the default one
+	DefaultLang string
+	// FallbackLang replacement of the language in fallback chain, it's required if the language to unload is in it
+	FallbackLang string
+}
+
+// Unload remove a loaded language, other languages keep serving.
+// unloading the default language or a language of fallback chain is refused unless a loaded replacement is
+// provided by opts, subscribers of OnLangChange are notified of the replacement
+func Unload(lang string, opts ...UnloadOpts) error {
+	if !status.Initialized {
+		return errors.ErrorNotInitialized
+	}
+	if !i18nFS.HasLang(lang) {
+		return errors.GetLangNotFound(lang)
+	}
+
+	var unloadOpts UnloadOpts
+	if len(opts) > 0 {
+		unloadOpts = opts[0]
+	}
+	langs := getLangs()
+	defLang, fallbackChain := langs.defaultLang, slices.Clone(langs.fallbackChain)
+	if lang == defLang {
+		if unloadOpts.DefaultLang == "" {
+			return errors.GetLangInUse("default", lang)
+		}
+		defLang = unloadOpts.DefaultLang
+	}
+	if idx := slices.Index(fallbackChain, lang); idx >= 0 {
+		if unloadOpts.FallbackLang == "" {
+			return errors.GetLangInUse("fallback", lang)
+		}
+		fallbackChain[idx] = unloadOpts.FallbackLang
+	}
+	if defLang == lang || !i18nFS.HasLang(defLang) {
+		return errors.GetSpecificTypeLangNotFound("default", defLang)
+	}
+	for _, fbLang := range fallbackChain {
+		if fbLang == lang || !i18nFS.HasLang(fbLang) {
+			return errors.GetSpecificTypeLangNotFound("fallback", fbLang)
+		}
+	}
+
+	// languages are switched first, so lookups never use the unloaded language
+	updateLangs(func(*langState) *langState {
+		return newLangState(defLang, fallbackChain)
+	}, true)
+	pseudoLocales.Delete(lang)
+	return i18nFS.Unload(lang)
+}
+
+// Reload read files of a loaded language again, its values are replaced atomically once all files are read,
+// so lookups keep working during reloading. messages added at runtime are kept.
+// pseudo-locales are generated again from their source
+func Reload(lang string) error {
+	if !status.Initialized {
+		return errors.ErrorNotInitialized
+	}
+	if !i18nFS.HasLang(lang) {
+		return errors.GetLangNotFound(lang)
+	}
+
+	if ok, err := reloadPseudoLocale(lang); ok {
+		return err
+	}
+	return i18nFS.Read(lang)
+}
@@ -0,0 +1,228 @@
+// Package i18nanalysis provides an analyzer which validates literal paths passed to package i18n at vet time.
+//
+// The catalog is located by flags "catalog" and "lang", or by a config file named ".i18n.yaml" which is found in
+// directory of package or any parent of it:
+//
+//	# root directory of catalog, relative to the config file
+//	dir: lang
+//	# default language which paths are validated against
+//	default: en
+//	# values of each file are under keys of its relative path, the same as FSOpts.Namespaced of package i18n
+//	namespaced: true
+package i18nanalysis
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/i18nextract"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/internal/catalog"
+	"github.com/hanakogo/i18n/internal/structs"
+	"github.com/hanakogo/i18n/internal/utils"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ConfigFileName name of config file which locates the catalog
+const ConfigFileName = ".i18n.yaml"
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "i18n",
+	Doc:      "check literal paths passed to package github.com/hanakogo/i18n exist, and arguments of GetStringF match verbs",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var (
+	catalogDir  string
+	defaultLang string
+	namespaced  bool
+)
+
+func init() {
+	Analyzer.Flags.StringVar(&catalogDir, "catalog", "", "root directory of catalog (default is found from "+ConfigFileName+")")
+	Analyzer.Flags.StringVar(&defaultLang, "lang", "", "default language which paths are validated against")
+	Analyzer.Flags.BoolVar(&namespaced, "namespaced", false, "values of each file are under keys of its relative path")
+}
+
+// formatFuncs index of the first argument which is formatted, keyed by name of function
+var formatFuncs = map[string]int{
+	"GetStringF":   1,
+	"GetStringTrF": 2,
+}
+
+type config struct {
+	Dir        string `yaml:"dir"`
+	Default    string `yaml:"default"`
+	Namespaced bool   `yaml:"namespaced"`
+}
+
+// catalogs loaded catalogs keyed by "dir:lang:namespaced", analyzer runs for each package, but catalog is loaded once
+var catalogs sync.Map
+
+type loadedCatalog struct {
+	once    sync.Once
+	catalog *catalog.Catalog
+	err     error
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if !importsI18n(pass.Pkg) {
+		return nil, nil
+	}
+	c, lang, err := loadCatalog(pass)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		// no catalog is configured
+		return nil, nil
+	}
+
+	nodeInspector := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeInspector.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(node ast.Node) {
+		call := node.(*ast.CallExpr)
+		fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != i18nextract.ImportPath {
+			return
+		}
+		argIdx, ok := i18nextract.PathArgs[fn.Name()]
+		if !ok || argIdx >= len(call.Args) {
+			return
+		}
+		pathArg := call.Args[argIdx]
+		path, ok := constString(pass, pathArg)
+		if !ok {
+			return
+		}
+		value, err := c.Lookup(lang, path)
+		if err != nil {
+			pass.Reportf(pathArg.Pos(), "path %q is not defined in default language [%s]", path, lang)
+			return
+		}
+		if firstArgIdx, ok := formatFuncs[fn.Name()]; ok {
+			if message, ok := value.(string); ok {
+				checkFormat(pass, call, path, message, firstArgIdx)
+			}
+		}
+	})
+	return nil, nil
+}
+
+// importsI18n check package imports package i18n, other packages are skipped quickly
+func importsI18n(pkg *types.Package) bool {
+	for _, imported := range pkg.Imports() {
+		if imported.Path() == i18nextract.ImportPath {
+			return true
+		}
+	}
+	return false
+}
+
+// constString value of expr if it's a constant string
+func constString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	typeAndValue, ok := pass.TypesInfo.Types[expr]
+	if !ok || typeAndValue.Value == nil || typeAndValue.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(typeAndValue.Value), true
+}
+
+// loadCatalog load catalog located by flags or config file, nil is returned if nothing is configured
+func loadCatalog(pass *analysis.Pass) (*catalog.Catalog, string, error) {
+	dir, lang, isNamespaced := catalogDir, defaultLang, namespaced
+	if dir == "" {
+		cfg, cfgDir, err := findConfig(pass)
+		if err != nil {
+			return nil, "", err
+		}
+		if cfg == nil {
+			return nil, "", nil
+		}
+		dir = filepath.Join(cfgDir, cfg.Dir)
+		if lang == "" {
+			lang = cfg.Default
+		}
+		isNamespaced = isNamespaced || cfg.Namespaced
+	}
+	if lang == "" {
+		return nil, "", fmt.Errorf("default language of catalog %s is not configured", dir)
+	}
+
+	value, _ := catalogs.LoadOrStore(fmt.Sprintf("%s:%s:%t", dir, lang, isNamespaced), &loadedCatalog{})
+	loaded := value.(*loadedCatalog)
+	loaded.once.Do(func() {
+		i18nFS, err := structs.NewI18nFS(i18nfs.ModeFileSystem, dir, nil)
+		if err != nil {
+			loaded.err = err
+			return
+		}
+		i18nFS.Namespaced = isNamespaced
+		loaded.catalog, loaded.err = catalog.Load(i18nFS, []string{lang})
+	})
+	return loaded.catalog, lang, loaded.err
+}
+
+// findConfig find config file from directory of package to root
+func findConfig(pass *analysis.Pass) (*config, string, error) {
+	if len(pass.Files) == 0 {
+		return nil, "", nil
+	}
+	dir := filepath.Dir(pass.Fset.Position(pass.Files[0].Pos()).Filename)
+	for {
+		content, err := os.ReadFile(filepath.Join(dir, ConfigFileName))
+		if err == nil {
+			cfg := &config{Dir: "lang"}
+			if err = yaml.Unmarshal(content, cfg); err != nil {
+				return nil, "", fmt.Errorf("%s: %w", filepath.Join(dir, ConfigFileName), err)
+			}
+			return cfg, dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, "", nil
+		}
+		dir = parent
+	}
+}
+
+// checkFormat check count and types of arguments match verbs of message
+func checkFormat(pass *analysis.Pass, call *ast.CallExpr, path string, message string, firstArgIdx int) {
+	// can't know count of arguments of "args..."
+	if call.Ellipsis.IsValid() {
+		return
+	}
+	verbs := utils.ParseFormatVerbs(message)
+	var args []ast.Expr
+	if firstArgIdx < len(call.Args) {
+		args = call.Args[firstArgIdx:]
+	}
+
+	if argCount := utils.FormatArgCount(verbs); argCount != len(args) {
+		pass.Reportf(call.Lparen, "message of path %q needs %d argument(s), but %d are given", path, argCount, len(args))
+		return
+	}
+
+	for _, verb := range verbs {
+		for _, starArgIndex := range verb.StarArgIndexes {
+			argType := pass.TypesInfo.TypeOf(args[starArgIndex])
+			if argType != nil && !isInteger(argType) {
+				pass.Reportf(args[starArgIndex].Pos(), "width or precision of %s in message of path %q must be int, but %s is given",
+					verb.Raw, path, argType)
+			}
+		}
+		argType := pass.TypesInfo.TypeOf(args[verb.ArgIndex])
+		if argType != nil && !verbAccepts(verb.Verb, argType) {
+			pass.Reportf(args[verb.ArgIndex].Pos(), "verb %s in message of path %q doesn't accept argument of type %s",
+				verb.Raw, path, argType)
+		}
+	}
+}
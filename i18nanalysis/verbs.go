@@ -0,0 +1,69 @@
+package i18nanalysis
+
+import (
+	"go/types"
+)
+
+// verbAccepts check argument of type can be formatted by verb, it's loose for types which aren't basic
+func verbAccepts(verb rune, argType types.Type) bool {
+	if verb == 'v' || verb == 'T' {
+		return true
+	}
+	// interface may hold anything, Formatter formats itself
+	if types.IsInterface(argType) || hasMethod(argType, "Format") {
+		return true
+	}
+
+	basic, ok := argType.Underlying().(*types.Basic)
+	if !ok {
+		// elements of composite types are formatted one by one, and all of pointers, maps, funcs can be printed,
+		// so they aren't checked
+		return true
+	}
+
+	info := basic.Info()
+	switch {
+	case verb == 't':
+		return info&types.IsBoolean != 0
+	case strchr("dcU", verb):
+		return info&types.IsInteger != 0
+	case strchr("boO", verb):
+		return info&types.IsInteger != 0 || verb == 'b' && info&(types.IsFloat|types.IsComplex) != 0
+	case strchr("xX", verb):
+		return info&(types.IsInteger|types.IsFloat|types.IsComplex|types.IsString) != 0
+	case strchr("eEfFgG", verb):
+		return info&(types.IsFloat|types.IsComplex) != 0
+	case verb == 's':
+		return info&types.IsString != 0 || isStringer(argType)
+	case verb == 'q':
+		return info&(types.IsString|types.IsInteger) != 0 || isStringer(argType)
+	case verb == 'p':
+		return basic.Kind() == types.UnsafePointer
+	}
+	return false
+}
+
+func isInteger(argType types.Type) bool {
+	basic, ok := argType.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+// isStringer check type has method String() or Error()
+func isStringer(argType types.Type) bool {
+	return hasMethod(argType, "String") || hasMethod(argType, "Error")
+}
+
+func hasMethod(argType types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(argType, true, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+func strchr(s string, r rune) bool {
+	for _, c := range s {
+		if c == r {
+			return true
+		}
+	}
+	return false
+}
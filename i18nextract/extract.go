@@ -0,0 +1,224 @@
+package i18nextract
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ImportPath import path of package whose calls are extracted
+const ImportPath = "github.com/hanakogo/i18n"
+
+// PathArgs index of argument which is a path, keyed by name of function in package i18n
+var PathArgs = map[string]int{
+	"HasPath":       0,
+	"Get":           0,
+	"GetValue":      0,
+	"GetString":     0,
+	"GetStringF":    0,
+	"GetInt64":      0,
+	"GetFloat":      0,
+	"GetSlice":      0,
+	"GetBool":       0,
+	"GetUint64":     0,
+	"GetDuration":   0,
+	"GetTime":       0,
+	"GetBigFloat":   0,
+	"GetMessage":    0,
+	"GetTr":         1,
+	"GetValueTr":    1,
+	"GetStringTr":   1,
+	"GetStringTrF":  1,
+	"GetInt64Tr":    1,
+	"GetFloatTr":    1,
+	"GetSliceTr":    1,
+	"GetBoolTr":     1,
+	"GetUint64Tr":   1,
+	"GetDurationTr": 1,
+	"GetTimeTr":     1,
+	"GetBigFloatTr": 1,
+	"GetMessageTr":  1,
+}
+
+// Usage a call of function in package i18n with a literal path
+type Usage struct {
+	Func   string
+	Path   string
+	File   string
+	Line   int
+	Column int
+}
+
+type Opts struct {
+	// Tests also extract from _test.go files
+	Tests bool
+}
+
+// Extract parse go files of packages and extract all usages, usages are sorted by location.
+//
+// pattern is a directory, or a directory ends with "/..." to include all subdirectories,
+// directories named "vendor" or "testdata" and hidden directories are skipped in recursive mode
+func Extract(opts Opts, patterns ...string) ([]Usage, error) {
+	var usages []Usage
+	for _, pattern := range patterns {
+		dir, recursive := strings.CutSuffix(pattern, "...")
+		dir = filepath.Clean(dir)
+		if !recursive {
+			dirUsages, err := extractDir(opts, dir)
+			if err != nil {
+				return nil, err
+			}
+			usages = append(usages, dirUsages...)
+			continue
+		}
+		err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !entry.IsDir() {
+				return nil
+			}
+			name := entry.Name()
+			if path != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			dirUsages, err := extractDir(opts, path)
+			if err != nil {
+				return err
+			}
+			usages = append(usages, dirUsages...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(usages, func(a, b Usage) int {
+		if a.File != b.File {
+			return strings.Compare(a.File, b.File)
+		}
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	return usages, nil
+}
+
+func extractDir(opts Opts, dir string) ([]Usage, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var usages []Usage
+	fileSet := token.NewFileSet()
+	for _, entry := range dirEntries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if !opts.Tests && strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, ExtractFile(fileSet, file)...)
+	}
+	return usages, nil
+}
+
+// ExtractFile extract usages from a parsed file, calls with non-literal path are ignored
+func ExtractFile(fileSet *token.FileSet, file *ast.File) (usages []Usage) {
+	localName, imported := importName(file)
+	if !imported {
+		return nil
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		funcName, ok := calledFunc(call, localName)
+		if !ok {
+			return true
+		}
+		argIdx, ok := PathArgs[funcName]
+		if !ok || argIdx >= len(call.Args) {
+			return true
+		}
+		path, ok := StringLiteral(call.Args[argIdx])
+		if !ok {
+			return true
+		}
+		position := fileSet.Position(call.Args[argIdx].Pos())
+		usages = append(usages, Usage{
+			Func:   funcName,
+			Path:   path,
+			File:   position.Filename,
+			Line:   position.Line,
+			Column: position.Column,
+		})
+		return true
+	})
+	return
+}
+
+// importName local name of package i18n in file, "." means dot import
+func importName(file *ast.File) (name string, ok bool) {
+	for _, importSpec := range file.Imports {
+		importPath, err := strconv.Unquote(importSpec.Path.Value)
+		if err != nil || importPath != ImportPath {
+			continue
+		}
+		if importSpec.Name != nil {
+			return importSpec.Name.Name, importSpec.Name.Name != "_"
+		}
+		return "i18n", true
+	}
+	return "", false
+}
+
+// calledFunc name of function in package i18n which is called, type arguments are allowed
+func calledFunc(call *ast.CallExpr, localName string) (string, bool) {
+	fun := call.Fun
+	switch expr := fun.(type) {
+	case *ast.IndexExpr:
+		fun = expr.X
+	case *ast.IndexListExpr:
+		fun = expr.X
+	}
+	switch expr := fun.(type) {
+	case *ast.SelectorExpr:
+		if ident, ok := expr.X.(*ast.Ident); ok && ident.Name == localName {
+			return expr.Sel.Name, true
+		}
+	case *ast.Ident:
+		if localName == "." {
+			return expr.Name, true
+		}
+	}
+	return "", false
+}
+
+// StringLiteral value of expr if it's a string literal
+func StringLiteral(expr ast.Expr) (string, bool) {
+	basicLit, ok := expr.(*ast.BasicLit)
+	if !ok || basicLit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(basicLit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
@@ -0,0 +1,124 @@
+package i18nextract
+
+import (
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/internal/catalog"
+	"github.com/hanakogo/i18n/internal/structs"
+	"github.com/hanakogo/i18n/internal/utils"
+	"slices"
+	"strings"
+)
+
+// UnusedKey a path of catalog which is never used
+type UnusedKey struct {
+	Path   string
+	File   string
+	Line   int
+	Column int
+}
+
+// Report result of comparing usages with catalog
+type Report struct {
+	// Unused paths of catalog which are never used, sorted by path
+	Unused []UnusedKey
+	// Undefined usages whose path doesn't exist in catalog
+	Undefined []Usage
+}
+
+type CheckOpts struct {
+	// Namespaced values of each file are under keys of its relative path, the same as FSOpts.Namespaced of i18n
+	Namespaced bool
+	// ConflictPolicy how paths defined by more than one file of a language are merged, the same as Opts.ConflictPolicy
+	// of i18n
+	ConflictPolicy i18nfs.ConflictPolicy
+}
+
+// Check compare usages with language lang of catalog in directory dir.
+//
+// a path is used if it's used directly, it's under a used object or list,
+// or it's referred by a template of another used path
+func Check(usages []Usage, dir string, lang string, opts ...CheckOpts) (*Report, error) {
+	var checkOpts CheckOpts
+	if len(opts) > 0 {
+		checkOpts = opts[0]
+	}
+	i18nFS, err := structs.NewI18nFS(i18nfs.ModeFileSystem, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	i18nFS.Namespaced = checkOpts.Namespaced
+	i18nFS.ConflictPolicy = checkOpts.ConflictPolicy
+	c, err := catalog.Load(i18nFS, []string{lang})
+	if err != nil {
+		return nil, err
+	}
+	language := c.Languages[lang]
+
+	report := &Report{}
+	used := make(map[string]bool)
+	var pending [][]string
+	for _, usage := range usages {
+		if _, err := c.Lookup(lang, usage.Path); err != nil {
+			report.Undefined = append(report.Undefined, usage)
+			continue
+		}
+		nodes, _ := utils.ParsePath(usage.Path)
+		pending = append(pending, keysOfNodes(nodes))
+	}
+
+	// mark all entries under used paths, and follow templates of them
+	for len(pending) > 0 {
+		keys := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for path, entry := range language.Entries {
+			if used[path] || !hasPrefix(entry.Keys, keys) {
+				continue
+			}
+			used[path] = true
+			stringVal, ok := entry.Value.(string)
+			if !ok {
+				continue
+			}
+			utils.ParseTemplates(stringVal, func(template string) string {
+				refLang, refPath := utils.SplitTemplateLang(template)
+				if refLang != "" && refLang != lang {
+					return template
+				}
+				if nodes, err := utils.ParsePath(refPath); err == nil {
+					pending = append(pending, keysOfNodes(nodes))
+				}
+				return template
+			})
+		}
+	}
+
+	for path, entry := range language.Entries {
+		if !used[path] {
+			report.Unused = append(report.Unused, UnusedKey{
+				Path:   path,
+				File:   entry.File,
+				Line:   entry.Line,
+				Column: entry.Column,
+			})
+		}
+	}
+	slices.SortFunc(report.Unused, func(a, b UnusedKey) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return report, nil
+}
+
+// keysOfNodes keys of nodes until the first node with indexes, values in list aren't tracked one by one
+func keysOfNodes(nodes []utils.PathNode) (keys []string) {
+	for _, node := range nodes {
+		keys = append(keys, node.Key)
+		if len(node.Indexes) > 0 {
+			break
+		}
+	}
+	return
+}
+
+func hasPrefix(keys []string, prefix []string) bool {
+	return len(keys) >= len(prefix) && slices.Equal(keys[:len(prefix)], prefix)
+}
@@ -0,0 +1,42 @@
+package i18nfs
+
+import "fmt"
+
+// ConflictPolicy decides what happens when files of the same language define the same path,
+// files are always merged in lexical order of their paths
+type ConflictPolicy int
+
+const (
+	// ConflictLastWins value of the later file is used silently
+	ConflictLastWins ConflictPolicy = iota
+	// ConflictFirstWins value of the earlier file is kept
+	ConflictFirstWins
+	// ConflictWarn like ConflictLastWins, but a warning is printed to stderr
+	ConflictWarn
+	// ConflictError loading fails
+	ConflictError
+)
+
+func (p ConflictPolicy) String() string {
+	switch p {
+	case ConflictLastWins:
+		return "last-wins"
+	case ConflictFirstWins:
+		return "first-wins"
+	case ConflictWarn:
+		return "warn"
+	case ConflictError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// ParseConflictPolicy parse policy from its name, e.g. "first-wins"
+func ParseConflictPolicy(name string) (ConflictPolicy, error) {
+	for _, policy := range []ConflictPolicy{ConflictLastWins, ConflictFirstWins, ConflictWarn, ConflictError} {
+		if policy.String() == name {
+			return policy, nil
+		}
+	}
+	return ConflictLastWins, fmt.Errorf("unknown conflict policy [%s]", name)
+}
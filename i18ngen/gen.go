@@ -0,0 +1,396 @@
+// Package i18ngen generates a go package with typed accessors of a catalog, so paths are checked by compiler.
+//
+// every object of catalog becomes a group and every value becomes a method of the group, e.g. value of path
+// "test.str1" is got by msgs.Test.Str1(lang). accessors delegate to GetStringTr, GetInt64Tr and so on of package i18n.
+// strings with printf verbs become functions whose parameters are typed by verbs, they delegate to GetStringTrF.
+// strings with named placeholders like "{count, number}" become functions with a parameter of each name, they delegate
+// to GetMessageTr
+package i18ngen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/hanakogo/i18n/i18nextract"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/internal/catalog"
+	"github.com/hanakogo/i18n/internal/structs"
+	"github.com/hanakogo/i18n/internal/utils"
+	"go/format"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+type Opts struct {
+	// Dir root directory of catalog
+	Dir string
+	// Lang language which types of values are taken from
+	Lang string
+	// Package name of generated package
+	Package string
+	// Namespaced values of each file are under keys of its relative path, the same as FSOpts.Namespaced of i18n
+	Namespaced bool
+	// ConflictPolicy how paths defined by more than one file of a language are merged, the same as Opts.ConflictPolicy
+	// of i18n
+	ConflictPolicy i18nfs.ConflictPolicy
+}
+
+// node an object or a value of catalog
+type node struct {
+	key      string
+	name     string
+	typeName string
+	children []*node
+	entry    *catalog.Entry
+}
+
+// Generate read language of catalog and generate source of go package, values which can't be typed
+// (null and lists of objects) are skipped
+func Generate(opts Opts) ([]byte, error) {
+	if opts.Package == "" {
+		return nil, fmt.Errorf("package name is empty")
+	}
+	i18nFS, err := structs.NewI18nFS(i18nfs.ModeFileSystem, opts.Dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	i18nFS.Namespaced = opts.Namespaced
+	i18nFS.ConflictPolicy = opts.ConflictPolicy
+	c, err := catalog.Load(i18nFS, []string{opts.Lang})
+	if err != nil {
+		return nil, err
+	}
+	if len(c.FileErrors) > 0 {
+		fileError := c.FileErrors[0]
+		return nil, fmt.Errorf("%s:%d: %v", fileError.File, fileError.Line, fileError.Err)
+	}
+
+	root := &node{}
+	for _, entry := range c.Languages[opts.Lang].Entries {
+		current := root
+		for _, key := range entry.Keys {
+			idx := slices.IndexFunc(current.children, func(child *node) bool { return child.key == key })
+			if idx < 0 {
+				current.children = append(current.children, &node{key: key})
+				idx = len(current.children) - 1
+			}
+			current = current.children[idx]
+		}
+		current.entry = entry
+	}
+
+	g := &generator{typeNames: make(map[string]bool)}
+	g.name(root, "group")
+	g.writeGroup(root, "")
+
+	// imports are known after all accessors are written
+	var source bytes.Buffer
+	_, _ = fmt.Fprintf(&source, "// Code generated by i18n gen; DO NOT EDIT.\n\npackage %s\n\nimport (\n", opts.Package)
+	_, _ = fmt.Fprintln(&source, strconv.Quote(i18nextract.ImportPath))
+	if g.usesTime {
+		_, _ = fmt.Fprintln(&source, strconv.Quote("time"))
+	}
+	_, _ = fmt.Fprintln(&source, ")")
+	source.Write(g.buf.Bytes())
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated source: %w", err)
+	}
+	return formatted, nil
+}
+
+type generator struct {
+	buf       bytes.Buffer
+	typeNames map[string]bool
+	usesTime  bool
+}
+
+func (g *generator) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(&g.buf, format, args...)
+}
+
+// name give names to children of n recursively, names in the same group are unique
+func (g *generator) name(n *node, typeName string) {
+	slices.SortFunc(n.children, func(a, b *node) int {
+		return strings.Compare(a.key, b.key)
+	})
+	used := make(map[string]bool)
+	for _, child := range n.children {
+		child.name = uniqueName(Identifier(child.key), used)
+		used[child.name] = true
+		if child.entry == nil {
+			child.typeName = uniqueName(typeName+child.name, g.typeNames)
+			g.typeNames[child.typeName] = true
+			g.name(child, child.typeName)
+		}
+	}
+}
+
+func uniqueName(name string, used map[string]bool) string {
+	unique := name
+	for n := 2; used[unique]; n++ {
+		unique = fmt.Sprintf("%s%d", name, n)
+	}
+	return unique
+}
+
+// writeGroup write type of group n, children of root group are package level variables and functions
+func (g *generator) writeGroup(n *node, typeName string) {
+	if typeName != "" {
+		g.printf("\ntype %s struct {\n", typeName)
+		for _, child := range n.children {
+			if child.entry == nil {
+				g.printf("%s %s\n", child.name, child.typeName)
+			}
+		}
+		g.printf("}\n")
+	} else {
+		for _, child := range n.children {
+			if child.entry == nil {
+				g.printf("\nvar %s %s\n", child.name, child.typeName)
+			}
+		}
+	}
+
+	for _, child := range n.children {
+		if child.entry != nil {
+			g.writeAccessor(child, typeName)
+		}
+	}
+	for _, child := range n.children {
+		if child.entry == nil {
+			g.writeGroup(child, child.typeName)
+		}
+	}
+}
+
+func (g *generator) writeAccessor(n *node, typeName string) {
+	entry := n.entry
+	path := strconv.Quote(entry.Path)
+	receiver := ""
+	if typeName != "" {
+		receiver = fmt.Sprintf("(%s) ", typeName)
+	}
+
+	var resultType, body string
+	params := []string{"lang string"}
+	switch value := entry.Value.(type) {
+	case string:
+		resultType = "string"
+		if placeholders := utils.ParsePlaceholders(value); len(placeholders) > 0 {
+			var args []string
+			for _, param := range g.namedParams(placeholders) {
+				args = append(args, fmt.Sprintf("%s: %s", strconv.Quote(param.key), param.name))
+				params = append(params, param.name+" "+param.typeName)
+			}
+			body = fmt.Sprintf("i18n.GetMessageTr(lang, %s, i18n.Args{%s})", path, strings.Join(args, ", "))
+			break
+		}
+		verbs := utils.ParseFormatVerbs(value)
+		if len(verbs) == 0 {
+			body = fmt.Sprintf("i18n.GetStringTr(lang, %s)", path)
+			break
+		}
+		var args []string
+		for idx, paramType := range paramTypes(verbs) {
+			arg := fmt.Sprintf("arg%d", idx+1)
+			args = append(args, arg)
+			params = append(params, arg+" "+paramType)
+		}
+		body = fmt.Sprintf("i18n.GetStringTrF(lang, %s, %s)", path, strings.Join(args, ", "))
+	case bool:
+		resultType, body = "bool", fmt.Sprintf("i18n.GetBoolTr(lang, %s)", path)
+	case int:
+		resultType, body = "int64", fmt.Sprintf("i18n.GetInt64Tr(lang, %s)", path)
+	case uint64:
+		resultType, body = "uint64", fmt.Sprintf("i18n.GetUint64Tr(lang, %s)", path)
+	case float64:
+		resultType, body = "float64", fmt.Sprintf("i18n.GetFloatTr(lang, %s)", path)
+	case time.Time:
+		g.usesTime = true
+		resultType, body = "time.Time", fmt.Sprintf(`i18n.GetTimeTr(lang, %s, "")`, path)
+	case []any:
+		elemType, convertFunc, ok := sliceType(value)
+		if !ok {
+			return
+		}
+		resultType, body = "[]"+elemType, fmt.Sprintf("i18n.GetSliceTr(lang, %s, i18n.%s)", path, convertFunc)
+	default:
+		return
+	}
+
+	g.printf("\n// %s %s: %s\n", n.name, entry.Path, summary(entry.Value))
+	g.printf("func %s%s(%s) %s {\n", receiver, n.name, strings.Join(params, ", "), resultType)
+	g.printf("return %s\n}\n", body)
+}
+
+type namedParam struct {
+	// key name of placeholder
+	key      string
+	name     string
+	typeName string
+}
+
+// namedParams parameters of named placeholders in order of their first use, typed by type of placeholder:
+// "number" is float64, "date", "time" and "datetime" are time.Time, others are any
+func (g *generator) namedParams(placeholders []utils.Placeholder) (params []namedParam) {
+	used := map[string]bool{"lang": true, "i18n": true, "time": true}
+	seen := make(map[string]int)
+	for _, placeholder := range placeholders {
+		typeName := "any"
+		switch placeholder.Type {
+		case "number":
+			typeName = "float64"
+		case "date", "time", "datetime":
+			typeName = "time.Time"
+		}
+		if idx, ok := seen[placeholder.Name]; ok {
+			// placeholder without type is typed by other placeholders of the same name, different types are any
+			switch params[idx].typeName {
+			case "any", typeName:
+				params[idx].typeName = typeName
+			default:
+				if typeName != "any" {
+					params[idx].typeName = "any"
+				}
+			}
+			continue
+		}
+		name := Identifier(placeholder.Name)
+		first, size := utf8.DecodeRuneInString(name)
+		name = string(unicode.ToLower(first)) + name[size:]
+		if token.IsKeyword(name) {
+			name += "Arg"
+		}
+		name = uniqueName(name, used)
+		used[name] = true
+		seen[placeholder.Name] = len(params)
+		params = append(params, namedParam{key: placeholder.Name, name: name, typeName: typeName})
+	}
+	g.usesTime = g.usesTime || slices.ContainsFunc(params, func(param namedParam) bool {
+		return param.typeName == "time.Time"
+	})
+	return
+}
+
+// paramTypes types of arguments needed by verbs, argument used by verbs of different types is any
+func paramTypes(verbs []utils.FormatVerb) []string {
+	types := make([]string, utils.FormatArgCount(verbs))
+	use := func(idx int, paramType string) {
+		if types[idx] == "" || types[idx] == paramType {
+			types[idx] = paramType
+		} else {
+			types[idx] = "any"
+		}
+	}
+	for _, verb := range verbs {
+		for _, starArgIndex := range verb.StarArgIndexes {
+			use(starArgIndex, "int")
+		}
+		use(verb.ArgIndex, verbType(verb.Verb))
+	}
+	for idx := range types {
+		// argument isn't referred by any verb
+		if types[idx] == "" {
+			types[idx] = "any"
+		}
+	}
+	return types
+}
+
+func verbType(verb rune) string {
+	switch verb {
+	case 'd', 'o', 'O':
+		return "int"
+	case 'c', 'U':
+		return "rune"
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		return "float64"
+	case 's', 'q':
+		return "string"
+	case 't':
+		return "bool"
+	default:
+		// verbs like %v and %x accept values of many types
+		return "any"
+	}
+}
+
+// sliceType type of elements and name of converter, list of numbers is float64 if any of them is not int
+func sliceType(list []any) (elemType string, convertFunc string, ok bool) {
+	for _, elem := range list {
+		var t, f string
+		switch elem.(type) {
+		case string:
+			t, f = "string", "ConvertString"
+		case bool:
+			t, f = "bool", "ConvertBool"
+		case int:
+			t, f = "int64", "ConvertInt64"
+		case float64:
+			t, f = "float64", "ConvertFloat"
+		default:
+			return "", "", false
+		}
+		switch {
+		case elemType == "" || elemType == t:
+			elemType, convertFunc = t, f
+		case elemType == "int64" && t == "float64" || elemType == "float64" && t == "int64":
+			elemType, convertFunc = "float64", "ConvertFloat"
+		default:
+			// mixed list is read as strings
+			elemType, convertFunc = "string", "ConvertString"
+		}
+	}
+	if elemType == "" {
+		elemType, convertFunc = "string", "ConvertString"
+	}
+	return elemType, convertFunc, true
+}
+
+// newlineReplacer collapse line breaks, they would end the doc comment
+var newlineReplacer = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ")
+
+// summary short description of value for doc comment, it's in one line
+func summary(value any) string {
+	const maxLen = 60
+	s := fmt.Sprint(value)
+	if stringVal, ok := value.(string); ok {
+		s = stringVal
+	}
+	s = newlineReplacer.Replace(s)
+	if utf8.RuneCountInString(s) > maxLen {
+		s = string([]rune(s)[:maxLen]) + "..."
+	}
+	if _, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// Identifier convert key to exported identifier, e.g. "str_list" or "str list" to "StrList",
+// "X" is added if it doesn't start with an upper letter
+func Identifier(key string) string {
+	var builder strings.Builder
+	upper := true
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		builder.WriteRune(r)
+	}
+	name := builder.String()
+	if first, _ := utf8.DecodeRuneInString(name); !unicode.IsUpper(first) {
+		name = "X" + name
+	}
+	return name
+}
@@ -0,0 +1,297 @@
+package i18nlint
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/internal/catalog"
+	"github.com/hanakogo/i18n/internal/structs"
+	"github.com/hanakogo/i18n/internal/utils"
+	"os"
+	"slices"
+	"strings"
+)
+
+type Rule string
+
+const (
+	// RuleYAML file can't be parsed
+	RuleYAML Rule = "yaml"
+	// RuleOverride path is defined again by another file (or the same file) of the same language
+	RuleOverride Rule = "override"
+	// RuleReference template refers a path which doesn't exist
+	RuleReference Rule = "reference"
+	// RuleCycle templates refer each other
+	RuleCycle Rule = "cycle"
+	// RuleFormat printf verbs or named placeholders are incompatible with default language
+	RuleFormat Rule = "format"
+	// RuleMissing path of default language doesn't exist in other language
+	RuleMissing Rule = "missing"
+)
+
+// Diagnostic a problem found by linting, located at File:Line:Column
+type Diagnostic struct {
+	Rule    Rule
+	Lang    string
+	Path    string
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// String format diagnostic as "file:line:column: [rule] message"
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: [%s] %s", d.File, d.Line, d.Column, d.Rule, d.Message)
+}
+
+type Opts struct {
+	// Dir root directory of catalog, each language is a subdirectory of it
+	Dir string
+	// DefaultLang other languages are compared with it
+	DefaultLang string
+	// Languages to lint, all subdirectories of Dir except CommonLang are linted if it's empty
+	Languages []string
+	// CommonLang directory of the shared layer, the same as Opts.CommonLang of i18n. Its values are inherited by every
+	// language, so they're not missing in any of them
+	CommonLang string
+	// Namespaced values of each file are under keys of its relative path, the same as FSOpts.Namespaced of i18n
+	Namespaced bool
+	// ConflictPolicy how paths defined by more than one file of a language are merged, the same as Opts.ConflictPolicy
+	// of i18n
+	ConflictPolicy i18nfs.ConflictPolicy
+}
+
+// Lint load catalog of directory and check all rules, diagnostics are sorted by location
+func Lint(opts Opts) ([]Diagnostic, error) {
+	i18nFS, err := structs.NewI18nFS(i18nfs.ModeFileSystem, opts.Dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	i18nFS.Namespaced = opts.Namespaced
+	i18nFS.ConflictPolicy = opts.ConflictPolicy
+	i18nFS.CommonLang = opts.CommonLang
+
+	languages := opts.Languages
+	if len(languages) == 0 {
+		languages, err = listLanguages(opts.Dir, opts.CommonLang)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !slices.Contains(languages, opts.DefaultLang) {
+		return nil, fmt.Errorf("default language [%s] is not in languages to lint", opts.DefaultLang)
+	}
+
+	c, err := catalog.Load(i18nFS, languages)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{catalog: c, defaultLang: opts.DefaultLang, languages: languages}
+	l.checkFiles()
+	l.checkOverrides()
+	l.checkReferences()
+	l.checkLanguages()
+
+	slices.SortFunc(l.diagnostics, func(a, b Diagnostic) int {
+		if a.File != b.File {
+			return strings.Compare(a.File, b.File)
+		}
+		if a.Line != b.Line {
+			return cmp.Compare(a.Line, b.Line)
+		}
+		if a.Column != b.Column {
+			return cmp.Compare(a.Column, b.Column)
+		}
+		if a.Rule != b.Rule {
+			return strings.Compare(string(a.Rule), string(b.Rule))
+		}
+		return strings.Compare(a.Message, b.Message)
+	})
+	// entries inherited from the common layer are checked with every language
+	return slices.Compact(l.diagnostics), nil
+}
+
+// listLanguages list all subdirectories of dir except the common layer
+func listLanguages(dir string, commonLang string) (languages []string, err error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range dirEntries {
+		if entry.IsDir() && entry.Name() != commonLang {
+			languages = append(languages, entry.Name())
+		}
+	}
+	return
+}
+
+type linter struct {
+	catalog     *catalog.Catalog
+	defaultLang string
+	languages   []string
+	diagnostics []Diagnostic
+}
+
+func (l *linter) report(rule Rule, entry *catalog.Entry, format string, args ...any) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		Rule:    rule,
+		Lang:    entry.Lang,
+		Path:    entry.Path,
+		File:    entry.File,
+		Line:    entry.Line,
+		Column:  entry.Column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// sortedEntries entries of language sorted by path, so diagnostics are deterministic
+func (l *linter) sortedEntries(lang string) []*catalog.Entry {
+	language := l.catalog.Languages[lang]
+	entries := make([]*catalog.Entry, 0, len(language.Entries))
+	for _, entry := range language.Entries {
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b *catalog.Entry) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return entries
+}
+
+func (l *linter) checkFiles() {
+	for _, fileError := range l.catalog.FileErrors {
+		l.diagnostics = append(l.diagnostics, Diagnostic{
+			Rule:    RuleYAML,
+			Lang:    fileError.Lang,
+			File:    fileError.File,
+			Line:    fileError.Line,
+			Message: fileError.Err.Error(),
+		})
+	}
+}
+
+func (l *linter) checkOverrides() {
+	for _, override := range l.catalog.Overrides {
+		if l.catalog.Policy == i18nfs.ConflictFirstWins {
+			l.report(RuleOverride, override.Loser,
+				"path [%s] is ignored, the value defined at %s is kept", override.Path, override.Winner.Location())
+			continue
+		}
+		l.report(RuleOverride, override.Winner,
+			"path [%s] overrides the value defined at %s", override.Path, override.Loser.Location())
+	}
+}
+
+func (l *linter) checkReferences() {
+	// edges of reference graph, from "lang:path" to "lang:path"
+	graph := make(map[string][]string)
+	entries := make(map[string]*catalog.Entry)
+
+	for _, lang := range l.languages {
+		for _, entry := range l.sortedEntries(lang) {
+			from := fmt.Sprintf("%s:%s", lang, entry.Path)
+			entries[from] = entry
+
+			stringVal, ok := entry.Value.(string)
+			if !ok {
+				continue
+			}
+			utils.ParseTemplates(stringVal, func(template string) string {
+				refLang, refPath := utils.SplitTemplateLang(template)
+				if refLang == "" {
+					refLang = lang
+				}
+				if _, ok := l.catalog.Languages[refLang]; !ok {
+					l.report(RuleReference, entry, "template ${%s} refers language [%s] which is not loaded", template, refLang)
+					return template
+				}
+				if _, err := l.catalog.Lookup(refLang, refPath); err != nil {
+					l.report(RuleReference, entry, "template ${%s} is broken: %v", template, err)
+					return template
+				}
+				// normalize quoting of path, so it's the same as key of entries
+				if nodes, err := utils.ParsePath(refPath); err == nil {
+					graph[from] = append(graph[from], fmt.Sprintf("%s:%s", refLang, utils.JoinPath(nodes, -1)))
+				}
+				return template
+			})
+		}
+	}
+
+	l.checkCycles(graph, entries)
+}
+
+// checkCycles find cycles of reference graph by depth-first search, each cycle is reported once
+func (l *linter) checkCycles(graph map[string][]string, entries map[string]*catalog.Entry) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[string]int)
+	var stack []string
+
+	var visit func(node string)
+	visit = func(node string) {
+		states[node] = visiting
+		stack = append(stack, node)
+		for _, next := range graph[node] {
+			// references to items of list or objects can't be a part of cycle
+			if entries[next] == nil {
+				continue
+			}
+			switch states[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				cycleStart := slices.Index(stack, next)
+				cycle := append(slices.Clone(stack[cycleStart:]), next)
+				l.report(RuleCycle, entries[next], "reference cycle: %s", strings.Join(cycle, " -> "))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		states[node] = visited
+	}
+
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+	for _, node := range nodes {
+		if states[node] == unvisited {
+			visit(node)
+		}
+	}
+}
+
+// checkLanguages compare other languages with default language
+func (l *linter) checkLanguages() {
+	for _, lang := range l.languages {
+		if lang == l.defaultLang {
+			continue
+		}
+		language := l.catalog.Languages[lang]
+		for _, defaultEntry := range l.sortedEntries(l.defaultLang) {
+			entry, ok := language.Entries[defaultEntry.Path]
+			if !ok {
+				l.report(RuleMissing, defaultEntry, "path [%s] is missing in language [%s]", defaultEntry.Path, lang)
+				continue
+			}
+
+			defaultString, ok := defaultEntry.Value.(string)
+			if !ok {
+				continue
+			}
+			stringVal, ok := entry.Value.(string)
+			if !ok {
+				continue
+			}
+			if err := utils.CompareFormats(defaultString, stringVal); err != nil {
+				l.report(RuleFormat, entry, "placeholders %v of path [%s] are incompatible with %v of default language [%s]: %v",
+					utils.RawFormats(stringVal), entry.Path, utils.RawFormats(defaultString), l.defaultLang, err)
+			}
+		}
+	}
+}
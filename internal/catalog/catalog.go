@@ -0,0 +1,261 @@
+package catalog
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/internal/structs"
+	"github.com/hanakogo/i18n/internal/utils"
+	"gopkg.in/yaml.v3"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Entry a value of catalog with the location where it's defined
+type Entry struct {
+	Lang   string
+	Path   string
+	Keys   []string
+	Value  any
+	File   string
+	Line   int
+	Column int
+}
+
+// Location format location of entry as "file:line:column"
+func (e *Entry) Location() string {
+	return fmt.Sprintf("%s:%d:%d", e.File, e.Line, e.Column)
+}
+
+// FileError a yaml file which can't be parsed
+type FileError struct {
+	Lang string
+	File string
+	Line int
+	Err  error
+}
+
+// Override a path which is defined again by a later file of the same language, Winner is the one in effect,
+// it's the later one unless policy is ConflictFirstWins
+type Override struct {
+	Lang   string
+	Path   string
+	Winner *Entry
+	Loser  *Entry
+}
+
+// Language all values of a language
+type Language struct {
+	Name string
+	// Entries values keyed by full path, include entries inherited from the common layer
+	Entries map[string]*Entry
+	// Values merged map with values of the common layer beneath, paths are looked up like what I18nFS does
+	Values map[string]any
+}
+
+// Catalog values of languages with their locations, it's used by tools which need to report positions
+type Catalog struct {
+	Languages  map[string]*Language
+	FileErrors []FileError
+	Overrides  []Override
+	// Policy ConflictPolicy of I18nFS, values are merged like what I18nFS does
+	Policy i18nfs.ConflictPolicy
+}
+
+var yamlLineRegexp = regexp.MustCompile(`line (\d+)`)
+
+// Load read all yaml files of languages, files which can't be parsed are recorded instead of stopping loading.
+// CommonLang of I18nFS is loaded as the bottom layer of every language if it exists
+func Load(i18nFS *structs.I18nFS, languages []string) (*Catalog, error) {
+	catalog := &Catalog{Languages: make(map[string]*Language), Policy: i18nFS.ConflictPolicy}
+	var common *Language
+	if i18nFS.CommonLang != "" && i18nFS.IsLangExists(i18nFS.CommonLang) {
+		var err error
+		if common, err = catalog.loadLanguage(i18nFS, i18nFS.CommonLang); err != nil {
+			return nil, err
+		}
+	}
+	for _, lang := range languages {
+		if lang == i18nFS.CommonLang && common != nil {
+			continue
+		}
+		if !i18nFS.IsLangExists(lang) {
+			return nil, fmt.Errorf("language [%s] is not exists", lang)
+		}
+		language, err := catalog.loadLanguage(i18nFS, lang)
+		if err != nil {
+			return nil, err
+		}
+		if common != nil {
+			language.inherit(common)
+		}
+	}
+	return catalog, nil
+}
+
+func (c *Catalog) loadLanguage(i18nFS *structs.I18nFS, lang string) (*Language, error) {
+	language := &Language{
+		Name:    lang,
+		Entries: make(map[string]*Entry),
+		Values:  make(map[string]any),
+	}
+	err := i18nFS.WalkLangYAML(lang, func(file string, content []byte) error {
+		c.loadFile(language, file, content, i18nFS.FileNamespace(lang, file))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.Languages[lang] = language
+	return language, nil
+}
+
+// inherit add entries of common layer which aren't hidden by values of language, like what lookup of I18nFS does,
+// Lang of inherited entries is still the common layer
+func (l *Language) inherit(common *Language) {
+	// checked before adding any of them, values of common layer don't hide each other
+	var inherited []*Entry
+	for _, entry := range common.Entries {
+		if !hidden(l.Values, entry.Keys) {
+			inherited = append(inherited, entry)
+		}
+	}
+	for _, entry := range inherited {
+		l.Entries[entry.Path] = entry
+		utils.SetStringMap(l.Values, entry.Keys, entry.Value)
+	}
+}
+
+// hidden check values has the path, or a value (not object) on the way of it
+func hidden(values map[string]any, keys []string) bool {
+	for n := 1; n <= len(keys); n++ {
+		value, ok := utils.GetStringMap(values, keys[:n])
+		if !ok {
+			return false
+		}
+		if _, isMap := value.(map[string]any); !isMap || n == len(keys) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Catalog) loadFile(language *Language, file string, content []byte, namespace []string) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		line := 0
+		if matches := yamlLineRegexp.FindStringSubmatch(err.Error()); matches != nil {
+			line, _ = strconv.Atoi(matches[1])
+		}
+		c.FileErrors = append(c.FileErrors, FileError{Lang: language.Name, File: file, Line: line, Err: err})
+		return
+	}
+	// empty file
+	if len(document.Content) == 0 {
+		return
+	}
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		c.FileErrors = append(c.FileErrors, FileError{
+			Lang: language.Name,
+			File: file,
+			Line: root.Line,
+			Err:  fmt.Errorf("top level of file must be a mapping"),
+		})
+		return
+	}
+	c.walkMapping(language, file, root, namespace)
+}
+
+func (c *Catalog) walkMapping(language *Language, file string, node *yaml.Node, keys []string) {
+	for idx := 0; idx+1 < len(node.Content); idx += 2 {
+		keyNode, valueNode := node.Content[idx], node.Content[idx+1]
+		entryKeys := append(keys[:len(keys):len(keys)], keyNode.Value)
+		if valueNode.Kind == yaml.AliasNode {
+			valueNode = valueNode.Alias
+		}
+		// empty mapping is ignored like what MergeStringMap does
+		if valueNode.Kind == yaml.MappingNode {
+			c.walkMapping(language, file, valueNode, entryKeys)
+			continue
+		}
+
+		var value any
+		if err := valueNode.Decode(&value); err != nil {
+			c.FileErrors = append(c.FileErrors, FileError{Lang: language.Name, File: file, Line: valueNode.Line, Err: err})
+			continue
+		}
+		entry := &Entry{
+			Lang:   language.Name,
+			Path:   utils.FormatPath(entryKeys...),
+			Keys:   entryKeys,
+			Value:  value,
+			File:   file,
+			Line:   keyNode.Line,
+			Column: keyNode.Column,
+		}
+		if !c.resolveConflicts(language, entry) {
+			continue
+		}
+		language.Entries[entry.Path] = entry
+		utils.SetStringMap(language.Values, entryKeys, value)
+	}
+}
+
+// resolveConflicts resolve conflicts of entry with previous entries by Policy like StringMapMerger does, conflicts are
+// the same path, values under the path (an object is replaced by value) and values on the way of path (a value is
+// replaced by object). false is returned if entry loses and must be ignored
+func (c *Catalog) resolveConflicts(language *Language, entry *Entry) bool {
+	previous := conflicts(language, entry)
+	if len(previous) == 0 {
+		return true
+	}
+	if c.Policy == i18nfs.ConflictFirstWins {
+		c.Overrides = append(c.Overrides, Override{Lang: language.Name, Path: entry.Path, Winner: previous[0], Loser: entry})
+		return false
+	}
+	for _, loser := range previous {
+		c.Overrides = append(c.Overrides, Override{Lang: language.Name, Path: loser.Path, Winner: entry, Loser: loser})
+		delete(language.Entries, loser.Path)
+	}
+	return true
+}
+
+// conflicts previous entries which conflict with entry, sorted by path
+func conflicts(language *Language, entry *Entry) (entries []*Entry) {
+	add := func(path string) {
+		if previous, ok := language.Entries[path]; ok {
+			entries = append(entries, previous)
+		}
+	}
+
+	for n := 1; n < len(entry.Keys); n++ {
+		add(utils.FormatPath(entry.Keys[:n]...))
+	}
+	add(entry.Path)
+	if previous, ok := utils.GetStringMap(language.Values, entry.Keys); ok {
+		if previousMap, ok := previous.(map[string]any); ok {
+			utils.WalkStringMap(previousMap, func(_ any, path []string) {
+				add(utils.FormatPath(append(entry.Keys[:len(entry.Keys):len(entry.Keys)], path...)...))
+			})
+		}
+	}
+	slices.SortFunc(entries, func(a, b *Entry) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return
+}
+
+// Lookup get value of path from merged map of language, template strings aren't parsed
+func (c *Catalog) Lookup(lang string, path string) (any, error) {
+	language, ok := c.Languages[lang]
+	if !ok {
+		return nil, fmt.Errorf("language [%s] is not found", lang)
+	}
+	nodes, err := utils.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return utils.TakeStringMapByNodes(language.Values, nodes)
+}
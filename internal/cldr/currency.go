@@ -0,0 +1,39 @@
+package cldr
+
+// CurrencyPattern placement of currency symbol in standard currency format
+type CurrencyPattern struct {
+	// Before symbol is before number like "$1.00", or after it like "1,00 €"
+	Before bool
+	// Space between symbol and number
+	Space string
+}
+
+var (
+	symbolBefore      = CurrencyPattern{Before: true}
+	symbolBeforeSpace = CurrencyPattern{Before: true, Space: "\u00a0"}
+	symbolAfterSpace  = CurrencyPattern{Space: "\u00a0"}
+)
+
+var currencyPatterns = map[string]CurrencyPattern{
+	"en": symbolBefore, "es-MX": symbolBefore, "es-US": symbolBefore, "hi": symbolBefore, "ja": symbolBefore,
+	"ko": symbolBefore, "th": symbolBefore, "tr": symbolBefore, "zh": symbolBefore,
+
+	"de-AT": symbolBeforeSpace, "de-CH": symbolBeforeSpace, "it-CH": symbolBeforeSpace, "nl": symbolBeforeSpace,
+	"pt": symbolBeforeSpace,
+
+	"ar": symbolAfterSpace, "cs": symbolAfterSpace, "da": symbolAfterSpace, "de": symbolAfterSpace,
+	"es": symbolAfterSpace, "fi": symbolAfterSpace, "fr": symbolAfterSpace, "it": symbolAfterSpace,
+	"nb": symbolAfterSpace, "pl": symbolAfterSpace, "pt-PT": symbolAfterSpace, "ru": symbolAfterSpace,
+	"sv": symbolAfterSpace, "uk": symbolAfterSpace, "vi": symbolAfterSpace,
+}
+
+// Currency get currency pattern of language, candidates are tried in order (e.g. "de-CH", "de"), pattern of root
+// like "¤ 1.00" is returned if none of them has data
+func Currency(candidates ...string) CurrencyPattern {
+	for _, candidate := range candidates {
+		if pattern, ok := currencyPatterns[candidate]; ok {
+			return pattern
+		}
+	}
+	return symbolBeforeSpace
+}
@@ -0,0 +1,202 @@
+// Package cldr date and time symbols, date and currency patterns of CLDR, golang.org/x/text doesn't provide them.
+// only a set of common languages is included
+package cldr
+
+import "slices"
+
+// Style index of full, long, medium and short patterns
+type Style int
+
+const (
+	Full Style = iota
+	Long
+	Medium
+	Short
+)
+
+// DateSymbols names and patterns of a language, weekdays start from Sunday
+type DateSymbols struct {
+	Months       [12]string
+	MonthsAbbr   [12]string
+	Weekdays     [7]string
+	WeekdaysAbbr [7]string
+	// DayPeriods names of AM and PM
+	DayPeriods [2]string
+	// DateFormats patterns of styles, indexed by Style
+	DateFormats [4]string
+	TimeFormats [4]string
+	// DateTimeFormats patterns which combine date "{1}" and time "{0}", indexed by Style of date
+	DateTimeFormats [4]string
+	// Skeletons patterns of skeletons like "yMMMd"
+	Skeletons map[string]string
+}
+
+var dateSymbols = map[string]*DateSymbols{
+	"en": {
+		Months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		MonthsAbbr:      [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Weekdays:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		WeekdaysAbbr:    [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		DayPeriods:      [2]string{"AM", "PM"},
+		DateFormats:     [4]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+		TimeFormats:     [4]string{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"},
+		DateTimeFormats: [4]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+		Skeletons: map[string]string{
+			"d": "d", "E": "ccc", "Ed": "d E", "y": "y", "yM": "M/y", "yMd": "M/d/y", "yMEd": "E, M/d/y",
+			"yMMM": "MMM y", "yMMMd": "MMM d, y", "yMMMEd": "E, MMM d, y", "yMMMM": "MMMM y", "yMMMMd": "MMMM d, y",
+			"M": "L", "Md": "M/d", "MEd": "E, M/d", "MMM": "LLL", "MMMd": "MMM d", "MMMEd": "E, MMM d",
+			"MMMMd": "MMMM d", "h": "h a", "H": "HH", "hm": "h:mm a", "Hm": "HH:mm", "hms": "h:mm:ss a",
+			"Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"en-GB": {
+		Months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		MonthsAbbr:      [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sept", "Oct", "Nov", "Dec"},
+		Weekdays:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		WeekdaysAbbr:    [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		DayPeriods:      [2]string{"am", "pm"},
+		DateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		TimeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		DateTimeFormats: [4]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+		Skeletons: map[string]string{
+			"d": "d", "E": "ccc", "Ed": "E d", "y": "y", "yM": "MM/y", "yMd": "dd/MM/y", "yMEd": "E, dd/MM/y",
+			"yMMM": "MMM y", "yMMMd": "d MMM y", "yMMMEd": "E, d MMM y", "yMMMM": "MMMM y", "yMMMMd": "d MMMM y",
+			"M": "L", "Md": "dd/MM", "MEd": "E dd/MM", "MMM": "LLL", "MMMd": "d MMM", "MMMEd": "E d MMM",
+			"MMMMd": "d MMMM", "h": "h a", "H": "HH", "hm": "h:mm a", "Hm": "HH:mm", "hms": "h:mm:ss a",
+			"Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"de": {
+		Months: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		MonthsAbbr: [12]string{
+			"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez.",
+		},
+		Weekdays:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		WeekdaysAbbr:    [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		DayPeriods:      [2]string{"AM", "PM"},
+		DateFormats:     [4]string{"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy"},
+		TimeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		DateTimeFormats: [4]string{"{1} 'um' {0}", "{1} 'um' {0}", "{1}, {0}", "{1}, {0}"},
+		Skeletons: map[string]string{
+			"d": "d", "E": "ccc", "Ed": "E, d.", "y": "y", "yM": "M/y", "yMd": "d.M.y", "yMEd": "E, d.M.y",
+			"yMMM": "MMM y", "yMMMd": "d. MMM y", "yMMMEd": "E, d. MMM y", "yMMMM": "MMMM y", "yMMMMd": "d. MMMM y",
+			"M": "L", "Md": "d.M.", "MEd": "E, d.M.", "MMM": "LLL", "MMMd": "d. MMM", "MMMEd": "E, d. MMM",
+			"MMMMd": "d. MMMM", "h": "h 'Uhr' a", "H": "HH 'Uhr'", "hm": "h:mm a", "Hm": "HH:mm",
+			"hms": "h:mm:ss a", "Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"fr": {
+		Months: [12]string{
+			"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+		},
+		MonthsAbbr: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		Weekdays:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		WeekdaysAbbr:    [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		DayPeriods:      [2]string{"AM", "PM"},
+		DateFormats:     [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+		TimeFormats:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+		DateTimeFormats: [4]string{"{1} 'à' {0}", "{1} 'à' {0}", "{1}, {0}", "{1} {0}"},
+		Skeletons: map[string]string{
+			"d": "d", "E": "E", "Ed": "E d", "y": "y", "yM": "MM/y", "yMd": "dd/MM/y", "yMEd": "E dd/MM/y",
+			"yMMM": "MMM y", "yMMMd": "d MMM y", "yMMMEd": "E d MMM y", "yMMMM": "MMMM y", "yMMMMd": "d MMMM y",
+			"M": "L", "Md": "dd/MM", "MEd": "E dd/MM", "MMM": "LLL", "MMMd": "d MMM", "MMMEd": "E d MMM",
+			"MMMMd": "d MMMM", "h": "h a", "H": "HH 'h'", "hm": "h:mm a", "Hm": "HH:mm", "hms": "h:mm:ss a",
+			"Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"es": {
+		Months: [12]string{
+			"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+		},
+		MonthsAbbr: [12]string{
+			"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic",
+		},
+		Weekdays:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		WeekdaysAbbr:    [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		DayPeriods:      [2]string{"a. m.", "p. m."},
+		DateFormats:     [4]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"},
+		TimeFormats:     [4]string{"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		DateTimeFormats: [4]string{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"},
+		Skeletons: map[string]string{
+			"d": "d", "E": "ccc", "Ed": "E d", "y": "y", "yM": "M/y", "yMd": "d/M/y", "yMEd": "EEE, d/M/y",
+			"yMMM": "MMM y", "yMMMd": "d MMM y", "yMMMEd": "EEE, d MMM y", "yMMMM": "MMMM 'de' y",
+			"yMMMMd": "d 'de' MMMM 'de' y", "M": "L", "Md": "d/M", "MEd": "E, d/M", "MMM": "LLL",
+			"MMMd": "d MMM", "MMMEd": "E, d MMM", "MMMMd": "d 'de' MMMM", "h": "h a", "H": "H",
+			"hm": "h:mm a", "Hm": "H:mm", "hms": "h:mm:ss a", "Hms": "H:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"ja": {
+		Months: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		MonthsAbbr: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		Weekdays:        [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		WeekdaysAbbr:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		DayPeriods:      [2]string{"午前", "午後"},
+		DateFormats:     [4]string{"y年M月d日EEEE", "y年M月d日", "y/MM/dd", "y/MM/dd"},
+		TimeFormats:     [4]string{"H時mm分ss秒 zzzz", "H:mm:ss z", "H:mm:ss", "H:mm"},
+		DateTimeFormats: [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		Skeletons: map[string]string{
+			"d": "d日", "E": "ccc", "Ed": "d日(E)", "y": "y年", "yM": "y/M", "yMd": "y/M/d", "yMEd": "y/M/d(E)",
+			"yMMM": "y年M月", "yMMMd": "y年M月d日", "yMMMEd": "y年M月d日(E)", "yMMMM": "y年M月", "yMMMMd": "y年M月d日",
+			"M": "M月", "Md": "M/d", "MEd": "M/d(E)", "MMM": "M月", "MMMd": "M月d日", "MMMEd": "M月d日(E)",
+			"MMMMd": "M月d日", "h": "aK時", "H": "H時", "hm": "aK:mm", "Hm": "H:mm", "hms": "aK:mm:ss",
+			"Hms": "H:mm:ss", "ms": "mm:ss",
+		},
+	},
+	"zh": {
+		Months: [12]string{
+			"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月",
+		},
+		MonthsAbbr: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		Weekdays:        [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+		WeekdaysAbbr:    [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+		DayPeriods:      [2]string{"上午", "下午"},
+		DateFormats:     [4]string{"y年M月d日EEEE", "y年M月d日", "y年M月d日", "y/M/d"},
+		TimeFormats:     [4]string{"zzzz HH:mm:ss", "z HH:mm:ss", "HH:mm:ss", "HH:mm"},
+		DateTimeFormats: [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+		Skeletons: map[string]string{
+			"d": "d日", "E": "ccc", "Ed": "d日E", "y": "y年", "yM": "y/M", "yMd": "y/M/d", "yMEd": "y/M/dE",
+			"yMMM": "y年M月", "yMMMd": "y年M月d日", "yMMMEd": "y年M月d日E", "yMMMM": "y年M月", "yMMMMd": "y年M月d日",
+			"M": "M月", "Md": "M/d", "MEd": "M/dE", "MMM": "LLL", "MMMd": "M月d日", "MMMEd": "M月d日E",
+			"MMMMd": "M月d日", "h": "ah时", "H": "H时", "hm": "ah:mm", "Hm": "HH:mm", "hms": "ah:mm:ss",
+			"Hms": "HH:mm:ss", "ms": "mm:ss",
+		},
+	},
+}
+
+// Dates get date symbols of language, candidates are tried in order (e.g. "en-GB", "en"), ok is false if none of them
+// has data
+func Dates(candidates ...string) (*DateSymbols, bool) {
+	for _, candidate := range candidates {
+		if symbols, ok := dateSymbols[candidate]; ok {
+			return symbols, true
+		}
+	}
+	return nil, false
+}
+
+// DateLanguages languages which have date symbols, sorted
+func DateLanguages() []string {
+	languages := make([]string, 0, len(dateSymbols))
+	for lang := range dateSymbols {
+		languages = append(languages, lang)
+	}
+	slices.Sort(languages)
+	return languages
+}
@@ -0,0 +1,202 @@
+package cldr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// field a run of the same pattern letter like "MMM", or a literal if letter is zero
+type field struct {
+	letter  byte
+	count   int
+	literal string
+}
+
+// parsePattern split CLDR pattern into fields, text in single quotes is literal and two
+// single quotes are a single quote
+func parsePattern(pattern string) (fields []field) {
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			fields = append(fields, field{literal: literal.String()})
+			literal.Reset()
+		}
+	}
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			if strings.HasPrefix(pattern[i:], "''") {
+				literal.WriteByte('\'')
+				i += 2
+				continue
+			}
+			end := strings.IndexByte(pattern[i+1:], '\'')
+			if end < 0 {
+				end = len(pattern) - i - 1
+			}
+			literal.WriteString(pattern[i+1 : i+1+end])
+			i += end + 2
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			flush()
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == c {
+				count++
+			}
+			fields = append(fields, field{letter: c, count: count})
+			i += count
+		default:
+			literal.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return
+}
+
+// FormatPattern format t by CLDR pattern like "MMM d, y" with names of symbols, numbers use ASCII digits,
+// time zones are abbreviations like "CET" for both "z" and "zzzz"
+func FormatPattern(pattern string, t time.Time, symbols *DateSymbols) string {
+	var builder strings.Builder
+	number := func(value int, count int) {
+		builder.WriteString(fmt.Sprintf("%0*d", count, value))
+	}
+	for _, f := range parsePattern(pattern) {
+		switch f.letter {
+		case 0:
+			builder.WriteString(f.literal)
+		case 'y':
+			if f.count == 2 {
+				number(t.Year()%100, 2)
+			} else {
+				number(t.Year(), f.count)
+			}
+		case 'M', 'L':
+			switch {
+			case f.count >= 4:
+				builder.WriteString(symbols.Months[t.Month()-1])
+			case f.count == 3:
+				builder.WriteString(symbols.MonthsAbbr[t.Month()-1])
+			default:
+				number(int(t.Month()), f.count)
+			}
+		case 'd':
+			number(t.Day(), f.count)
+		case 'E', 'c':
+			if f.count >= 4 {
+				builder.WriteString(symbols.Weekdays[t.Weekday()])
+			} else {
+				builder.WriteString(symbols.WeekdaysAbbr[t.Weekday()])
+			}
+		case 'a':
+			builder.WriteString(symbols.DayPeriods[t.Hour()/12])
+		case 'h':
+			number((t.Hour()+11)%12+1, f.count)
+		case 'H':
+			number(t.Hour(), f.count)
+		case 'K':
+			number(t.Hour()%12, f.count)
+		case 'k':
+			number((t.Hour()+23)%24+1, f.count)
+		case 'm':
+			number(t.Minute(), f.count)
+		case 's':
+			number(t.Second(), f.count)
+		case 'z':
+			zone, _ := t.Zone()
+			builder.WriteString(zone)
+		default:
+			// fields which aren't supported are kept
+			builder.WriteString(strings.Repeat(string(f.letter), f.count))
+		}
+	}
+	return builder.String()
+}
+
+// HourCycle change hours of pattern to 12 hours ("h12") or 24 hours ("h23"), other cycles are ignored.
+// day period is added before or after hours as 12 hours pattern of language does
+func (s *DateSymbols) HourCycle(pattern string, cycle string) string {
+	fields := parsePattern(pattern)
+	var changed []field
+	switch cycle {
+	case "h23", "h24":
+		for idx, f := range fields {
+			switch f.letter {
+			case 'h', 'K', 'k':
+				f.letter = 'H'
+			case 'a':
+				// day period and whitespace around it are removed
+				trimLiteral(changed, idx, fields)
+				continue
+			}
+			changed = append(changed, f)
+		}
+	case "h11", "h12":
+		hasPeriod := false
+		for _, f := range fields {
+			if f.letter == 'H' || f.letter == 'k' {
+				f.letter = 'h'
+				f.count = 1
+			}
+			hasPeriod = hasPeriod || f.letter == 'a'
+			changed = append(changed, f)
+		}
+		if !hasPeriod {
+			changed = insertPeriod(changed, strings.HasPrefix(s.Skeletons["hm"], "a"))
+		}
+	default:
+		return pattern
+	}
+	return formatFields(changed)
+}
+
+// trimLiteral trim whitespace between removed field idx and its neighbours
+func trimLiteral(changed []field, idx int, fields []field) {
+	if len(changed) > 0 && changed[len(changed)-1].letter == 0 {
+		changed[len(changed)-1].literal = strings.TrimRight(changed[len(changed)-1].literal, "   ")
+	}
+	if idx+1 < len(fields) && fields[idx+1].letter == 0 && len(changed) == 0 {
+		fields[idx+1].literal = strings.TrimLeft(fields[idx+1].literal, "   ")
+	}
+}
+
+// insertPeriod put day period right before the hour field if first is true, or after the last time field
+func insertPeriod(fields []field, first bool) []field {
+	at := -1
+	for idx, f := range fields {
+		if f.letter == 'h' && first {
+			at = idx
+			break
+		}
+		if f.letter != 0 && strings.IndexByte("hms", f.letter) >= 0 {
+			at = idx + 1
+		}
+	}
+	if at < 0 {
+		return fields
+	}
+	period := []field{{letter: 'a', count: 1}}
+	if !first {
+		period = []field{{literal: " "}, {letter: 'a', count: 1}}
+	}
+	return append(fields[:at], append(period, fields[at:]...)...)
+}
+
+// formatFields format fields back to pattern
+func formatFields(fields []field) string {
+	var builder strings.Builder
+	for _, f := range fields {
+		if f.letter != 0 {
+			builder.WriteString(strings.Repeat(string(f.letter), f.count))
+			continue
+		}
+		if f.literal == "" {
+			continue
+		}
+		builder.WriteString("'")
+		builder.WriteString(strings.ReplaceAll(f.literal, "'", "''"))
+		builder.WriteString("'")
+	}
+	return builder.String()
+}
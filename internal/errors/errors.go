@@ -18,3 +18,7 @@ func GetLangNotExists(lang string) error {
 func GetSpecificTypeLangNotFound(typ string, lang string) error {
 	return fmt.Errorf("%s language [%s] is not found", typ, lang)
 }
+
+func GetLangInUse(typ string, lang string) error {
+	return fmt.Errorf("language [%s] is the %s language, provide a replacement to unload it", lang, typ)
+}
@@ -5,19 +5,51 @@ import (
 	"fmt"
 	"github.com/gookit/goutil/fsutil"
 	"github.com/gookit/goutil/maputil"
-	"github.com/gookit/goutil/strutil"
 	"github.com/hanakogo/i18n/i18nfs"
 	"github.com/hanakogo/i18n/internal/errors"
 	"github.com/hanakogo/i18n/internal/utils"
+	"golang.org/x/sync/singleflight"
 	"gopkg.in/yaml.v3"
+	"sync"
 )
 
 type I18nFS struct {
+	// Name name of source, it's reported as Layer.Source
+	Name        string
 	LangFSEmbed *embed.FS
 	FSPrefix    string
 	FsMode      i18nfs.FSMode
+	// ConflictPolicy how paths defined by more than one file of a language are merged
+	ConflictPolicy i18nfs.ConflictPolicy
+	// Namespaced put values of each file under keys of its path relative to directory of language
+	Namespaced bool
+	// CommonLang directory of the shared layer, its values are visible to every language beneath their own values
+	CommonLang string
+
+	commonStringMap map[string]any
 
 	langStringMaps map[string]map[string]any
+	langOverrides  map[string][]utils.Override
+
+	// overlays sources whose values override values of this source, in order of precedence from low to high
+	overlays []*I18nFS
+	// runtimeMaps values added by AddMessages, they're above values of all sources
+	runtimeMaps map[string]map[string]any
+
+	// Lazy languages which exist in sources are read on first lookup, see ensureLoaded
+	Lazy bool
+	// loadGroup deduplicates concurrent first loads of the same language
+	loadGroup singleflight.Group
+	// lastUsed last time (unix nano) each loaded language is looked up, it's only tracked in lazy mode
+	lastUsed sync.Map
+	// missingLangs languages which don't exist in any source, so lookups of them don't read filesystem again
+	missingLangs struct {
+		sync.Mutex
+		langs map[string]struct{}
+	}
+
+	// mu guards maps of all sources, lookups are concurrent with reading and adding messages
+	mu sync.RWMutex
 }
 
 func NewI18nFS(fsMode i18nfs.FSMode, prefix string, embedFS *embed.FS) (*I18nFS, error) {
@@ -42,16 +74,30 @@ func NewI18nFS(fsMode i18nfs.FSMode, prefix string, embedFS *embed.FS) (*I18nFS,
 		FSPrefix:       prefix,
 		FsMode:         fsMode,
 		langStringMaps: make(map[string]map[string]any),
+		langOverrides:  make(map[string][]utils.Override),
+		runtimeMaps:    make(map[string]map[string]any),
 	}, nil
 }
 
 // GetLanguages get list of languages
 func (i *I18nFS) GetLanguages() []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
 	return maputil.Keys(i.langStringMaps)
 }
 
-// IsLangExists check language exists or not on filesystem
+// IsLangExists check language exists or not on filesystem of any source
 func (i *I18nFS) IsLangExists(lang string) bool {
+	for _, source := range i.sources() {
+		if source.existsInSource(lang) {
+			return true
+		}
+	}
+	return false
+}
+
+// existsInSource check language exists or not on filesystem of this source only
+func (i *I18nFS) existsInSource(lang string) bool {
 	langDir := i.filePathJoin(i.FSPrefix, lang)
 	switch i.FsMode {
 	case i18nfs.ModeEmbed:
@@ -65,88 +111,196 @@ func (i *I18nFS) IsLangExists(lang string) bool {
 	return true
 }
 
+// AddOverlay add a source whose values override values of this source and overlays added before,
+// ConflictPolicy, Namespaced and CommonLang are copied from this source, so it must be added after setting them
+func (i *I18nFS) AddOverlay(name string, fsMode i18nfs.FSMode, prefix string, embedFS *embed.FS) error {
+	overlay, err := NewI18nFS(fsMode, prefix, embedFS)
+	if err != nil {
+		return err
+	}
+	overlay.Name = name
+	overlay.ConflictPolicy = i.ConflictPolicy
+	overlay.Namespaced = i.Namespaced
+	overlay.CommonLang = i.CommonLang
+	i.overlays = append(i.overlays, overlay)
+	return nil
+}
+
+// sources this source and overlays, in order of precedence from low to high
+func (i *I18nFS) sources() []*I18nFS {
+	return append([]*I18nFS{i}, i.overlays...)
+}
+
 // HasLang check language in langStringMaps
 func (i *I18nFS) HasLang(lang string) bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.hasLang(lang)
+}
+
+func (i *I18nFS) hasLang(lang string) bool {
 	return maputil.HasKey(i.langStringMaps, lang)
 }
 
-// Read all yaml of language on filesystem into langStringMaps
-func (i *I18nFS) Read(lang string) error {
+// readResult values of a language read from a source
+type readResult struct {
+	source    *I18nFS
+	langMap   map[string]any
+	overrides []utils.Override
+}
+
+// readSources read language from all sources which have it, nothing is changed until all of them are read
+func (i *I18nFS) readSources(lang string) ([]readResult, error) {
 	if !i.IsLangExists(lang) {
-		return errors.GetLangNotExists(lang)
+		return nil, errors.GetLangNotExists(lang)
 	}
-	var langMaps []map[string]any
-	var err error
-	err = i.WalkLangYAML(lang, func(content string) {
-		dst := make(map[string]any)
-		err = yaml.Unmarshal([]byte(content), &dst)
-		langMaps = append(langMaps, dst)
-	})
+	var results []readResult
+	for _, source := range i.sources() {
+		result := readResult{source: source}
+		if source.existsInSource(lang) {
+			var err error
+			result.langMap, result.overrides, err = source.readLang(lang)
+			if err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Read all yaml of language of all sources into langStringMaps, language only needs to exist in one of sources.
+// files are read before locking, so lookups of other languages keep working
+func (i *I18nFS) Read(lang string) error {
+	results, err := i.readSources(lang)
 	if err != nil {
 		return err
 	}
-	mergedLangMap := make(map[string]any)
-	for _, langMap := range langMaps {
-		err = utils.MergeStringMap(&mergedLangMap, langMap, lang)
-		if err != nil {
-			return err
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, result := range results {
+		if result.langMap == nil {
+			delete(result.source.langStringMaps, lang)
+			delete(result.source.langOverrides, lang)
+			continue
 		}
+		result.source.langStringMaps[lang] = result.langMap
+		result.source.langOverrides[lang] = result.overrides
+	}
+	// language is loaded even if base source doesn't have it
+	if _, ok := i.langStringMaps[lang]; !ok {
+		i.langStringMaps[lang] = make(map[string]any)
 	}
-	i.langStringMaps[lang] = mergedLangMap
 	return nil
 }
 
+// ReadCommon read all yaml of CommonLang of all sources as the shared layer beneath every language
+func (i *I18nFS) ReadCommon() error {
+	results, err := i.readSources(i.CommonLang)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, result := range results {
+		result.source.commonStringMap = result.langMap
+		if result.langMap != nil {
+			result.source.langOverrides[i.CommonLang] = result.overrides
+		}
+	}
+	return nil
+}
+
+// readLang read and merge all yaml of language
+func (i *I18nFS) readLang(lang string) (map[string]any, []utils.Override, error) {
+	merger := utils.NewStringMapMerger(lang, i.ConflictPolicy)
+	err := i.WalkLangYAML(lang, func(file string, content []byte) error {
+		dst := make(map[string]any)
+		if err := yaml.Unmarshal(content, &dst); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if namespace := i.FileNamespace(lang, file); len(namespace) > 0 {
+			namespaced := make(map[string]any)
+			utils.SetStringMap(namespaced, namespace, dst)
+			dst = namespaced
+		}
+		return merger.Merge(dst, file)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return merger.Result, merger.Overrides, nil
+}
+
+// GetOverrides get paths of language which are defined by more than one file of the same source
+func (i *I18nFS) GetOverrides(lang string) (overrides []utils.Override) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	for _, source := range i.sources() {
+		overrides = append(overrides, source.langOverrides[lang]...)
+	}
+	return
+}
+
 // GetValByPath get value by paths which are split by dot
 func (i *I18nFS) GetValByPath(lang string, path string) (any, error) {
-	if !i.HasLang(lang) {
+	if err := i.ensureLoaded(lang); err != nil {
+		return "", err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.getValByPath(lang, path, nil)
+}
+
+// getValByPath referrers are the templates which have been followed to reach this path, like "lang:path"
+func (i *I18nFS) getValByPath(lang string, path string, referrers []string) (any, error) {
+	if !i.hasLayers(lang) {
 		return "", errors.GetLangNotFound(lang)
 	}
 
 	// validate path
-	paths, err := utils.ParsePath(path)
+	nodes, err := utils.ParsePath(path)
 	if err != nil {
 		return "", err
 	}
 
-	// walk all nodes of path, unless last one
-	langMap := i.langStringMaps[lang]
-	for i := range paths[:len(paths)-1] {
-		value := utils.TakeStringMap(&langMap, paths[i])
-
-		if value != nil {
-			// take out a Map, then continue
-			if value, ok := value.(map[string]any); ok {
-				langMap = value
-				continue
-			}
-		}
-
-		paths[i] = fmt.Sprintf("<%s>", paths[i])
-
-		// can't take out a Map, so we can't continue to walk deeper structure
-		return "", fmt.Errorf(
-			"destination path %s isn't point to a object, can't continue to get value",
-			strutil.Join(".", paths...),
-		)
+	value, _, err := i.lookup(lang, nodes)
+	if err != nil {
+		return "", err
 	}
-
-	// need some special handling for last one node
-	lastPath := paths[len(paths)-1]
-	value := utils.TakeStringMap(&langMap, lastPath)
-	// if value is nil or Map
+	// if value is nil
 	if value == nil {
 		return "", fmt.Errorf(
 			"destination path[%s] isn't point to a value",
-			strutil.Join(".", paths...),
+			utils.JoinPath(nodes, -1),
 		)
 	}
 
 	// do template formatting
 	if valString, ok := value.(string); ok {
-		if valStringParsed := i.ParseTemplateString(valString, lang); valStringParsed != nil {
+		if valStringParsed := i.parseTemplateString(valString, lang, referrers); valStringParsed != nil {
 			value = valStringParsed
 		}
 	}
 
 	return value, nil
 }
+
+// Unload remove values of language from all sources, include messages added at runtime
+func (i *I18nFS) Unload(lang string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.hasLang(lang) {
+		return errors.GetLangNotFound(lang)
+	}
+	for _, source := range i.sources() {
+		delete(source.langStringMaps, lang)
+		delete(source.langOverrides, lang)
+	}
+	delete(i.runtimeMaps, lang)
+	i.lastUsed.Delete(lang)
+	return nil
+}
@@ -0,0 +1,137 @@
+package structs
+
+import (
+	"fmt"
+	"github.com/gookit/goutil/maputil"
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/utils"
+	"slices"
+)
+
+// Keys list full paths of all values under prefix, list all values of language if prefix is empty
+func (i *I18nFS) Keys(lang string, prefix string) ([]string, error) {
+	if err := i.ensureLoaded(lang); err != nil {
+		return nil, err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if !i.hasLayers(lang) {
+		return nil, errors.GetLangNotFound(lang)
+	}
+
+	var keys []string
+	if prefix == "" {
+		err := i.walkLang(lang, func(_ any, path []string) {
+			keys = append(keys, utils.FormatPath(path...))
+		})
+		return keys, err
+	}
+
+	nodes, err := utils.ParsePath(prefix)
+	if err != nil {
+		return nil, err
+	}
+	value, _, err := i.lookup(lang, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	// normalize quoting of prefix
+	prefix = utils.JoinPath(nodes, -1)
+	subMap, ok := value.(map[string]any)
+	if !ok {
+		// prefix itself points to a value
+		return []string{prefix}, nil
+	}
+	prefixKeys := make([]string, len(nodes))
+	for idx, node := range nodes {
+		if len(node.Indexes) > 0 {
+			// object in list only exists in one layer
+			utils.WalkStringMap(subMap, func(_ any, path []string) {
+				keys = append(keys, fmt.Sprintf("%s.%s", prefix, utils.FormatPath(path...)))
+			})
+			return keys, nil
+		}
+		prefixKeys[idx] = node.Key
+	}
+	err = i.walkLang(lang, func(_ any, path []string) {
+		if len(path) > len(prefixKeys) && slices.Equal(path[:len(prefixKeys)], prefixKeys) {
+			keys = append(keys, utils.FormatPath(path...))
+		}
+	})
+	return keys, err
+}
+
+// Find list full paths of all values and objects which match pattern,
+// each key of pattern is a glob like "tips.*.title", "**" matches zero or more keys
+func (i *I18nFS) Find(lang string, pattern string) ([]string, error) {
+	if err := i.ensureLoaded(lang); err != nil {
+		return nil, err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if !i.hasLayers(lang) {
+		return nil, errors.GetLangNotFound(lang)
+	}
+
+	nodes, err := utils.ParsePath(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns := make([]string, len(nodes))
+	for idx, node := range nodes {
+		if len(node.Indexes) > 0 {
+			return nil, fmt.Errorf("pattern[%s] can't contain index", pattern)
+		}
+		patterns[idx] = node.Key
+	}
+
+	// objects are prefixes of values, so walking values is enough to find both of them
+	matched := make(map[string]bool)
+	err = i.walkLang(lang, func(_ any, path []string) {
+		for n := 1; n <= len(path); n++ {
+			if utils.MatchPath(patterns, path[:n]) {
+				matched[utils.FormatPath(path[:n]...)] = true
+			}
+		}
+	})
+	return maputil.Keys(matched), err
+}
+
+// WalkLang deep walk all values of language include values inherited from lower layers, template strings aren't parsed.
+// walkFunc must not call methods of I18nFS
+func (i *I18nFS) WalkLang(lang string, walkFunc func(value any, path []string)) error {
+	if err := i.ensureLoaded(lang); err != nil {
+		return err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.walkLang(lang, walkFunc)
+}
+
+func (i *I18nFS) walkLang(lang string, walkFunc func(value any, path []string)) error {
+	return i.walkLangLayers(lang, func(value any, path []string, _ string) {
+		walkFunc(value, path)
+	})
+}
+
+// GetRawValue get value by raw keys from the first layer which has it, template strings aren't parsed
+func (i *I18nFS) GetRawValue(lang string, keys []string) (any, bool) {
+	if err := i.ensureLoaded(lang); err != nil {
+		return nil, false
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if len(keys) == 0 {
+		return nil, false
+	}
+	for _, layer := range i.layers(lang) {
+		if hides(layer.Values, keys) {
+			return utils.GetStringMap(layer.Values, keys)
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,146 @@
+package structs
+
+import (
+	"github.com/hanakogo/i18n/internal/errors"
+	"github.com/hanakogo/i18n/internal/utils"
+)
+
+// RuntimeSource name of source of values which are added by AddMessages
+const RuntimeSource = "runtime"
+
+// Layer values of a language are looked up from layers in order, the first layer which has a path wins
+type Layer struct {
+	// Name language itself or CommonLang
+	Name string
+	// Source name of source which values are read from
+	Source string
+	Values map[string]any
+}
+
+// Layers get layers of language from top to bottom: values added at runtime, values of language itself,
+// then the common layer, values of later overlays are above earlier ones in each of them.
+// CommonLang itself can be looked up (e.g. by template "${common:path}") even if it isn't loaded as a language
+func (i *I18nFS) Layers(lang string) []Layer {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.layers(lang)
+}
+
+func (i *I18nFS) layers(lang string) (layers []Layer) {
+	sources := i.sources()
+	if i.hasLang(lang) {
+		if values, ok := i.runtimeMaps[lang]; ok {
+			layers = append(layers, Layer{Name: lang, Source: RuntimeSource, Values: values})
+		}
+		for idx := len(sources) - 1; idx >= 0; idx-- {
+			if values, ok := sources[idx].langStringMaps[lang]; ok {
+				layers = append(layers, Layer{Name: lang, Source: sources[idx].Name, Values: values})
+			}
+		}
+	}
+	if i.CommonLang != "" && (len(layers) > 0 || lang == i.CommonLang) {
+		for idx := len(sources) - 1; idx >= 0; idx-- {
+			if values := sources[idx].commonStringMap; values != nil {
+				layers = append(layers, Layer{Name: i.CommonLang, Source: sources[idx].Name, Values: values})
+			}
+		}
+	}
+	return
+}
+
+// hasLayers check language can be looked up
+func (i *I18nFS) hasLayers(lang string) bool {
+	return len(i.layers(lang)) > 0
+}
+
+// lookup take value of nodes from the first layer which has it, error of the top layer is returned if none has it
+func (i *I18nFS) lookup(lang string, nodes []utils.PathNode) (value any, layer Layer, err error) {
+	var firstErr error
+	for _, l := range i.layers(lang) {
+		value, err = utils.TakeStringMapByNodes(l.Values, nodes)
+		if err == nil {
+			return value, l, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		// e.g. path "a.b" of lower layers is hidden by value "a" of this layer
+		if hides(l.Values, nodeKeys(nodes)) {
+			break
+		}
+	}
+	return nil, Layer{}, firstErr
+}
+
+// nodeKeys keys of nodes until the first node with indexes
+func nodeKeys(nodes []utils.PathNode) (keys []string) {
+	for _, node := range nodes {
+		keys = append(keys, node.Key)
+		if len(node.Indexes) > 0 {
+			break
+		}
+	}
+	return
+}
+
+// Origin get layer which value of path comes from
+func (i *I18nFS) Origin(lang string, path string) (Layer, error) {
+	if err := i.ensureLoaded(lang); err != nil {
+		return Layer{}, err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if !i.hasLayers(lang) {
+		return Layer{}, errors.GetLangNotFound(lang)
+	}
+	nodes, err := utils.ParsePath(path)
+	if err != nil {
+		return Layer{}, err
+	}
+	_, layer, err := i.lookup(lang, nodes)
+	return layer, err
+}
+
+// WalkLangLayers deep walk values of all layers of language, values hidden by upper layers are skipped,
+// template strings aren't parsed. walkFunc must not call methods of I18nFS
+func (i *I18nFS) WalkLangLayers(lang string, walkFunc func(value any, path []string, layer string)) error {
+	if err := i.ensureLoaded(lang); err != nil {
+		return err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.walkLangLayers(lang, walkFunc)
+}
+
+func (i *I18nFS) walkLangLayers(lang string, walkFunc func(value any, path []string, layer string)) error {
+	layers := i.layers(lang)
+	if len(layers) == 0 {
+		return errors.GetLangNotFound(lang)
+	}
+	for idx, layer := range layers {
+		utils.WalkStringMap(layer.Values, func(value any, path []string) {
+			for _, upper := range layers[:idx] {
+				if hides(upper.Values, path) {
+					return
+				}
+			}
+			walkFunc(value, path, layer.Name)
+		})
+	}
+	return nil
+}
+
+// hides check values has the path, or a value (not object) on the way of it
+func hides(values map[string]any, keys []string) bool {
+	for n := 1; n <= len(keys); n++ {
+		value, ok := utils.GetStringMap(values, keys[:n])
+		if !ok {
+			return false
+		}
+		if _, isMap := value.(map[string]any); !isMap || n == len(keys) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package structs
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+// maxMissingLangs languages remembered as missing, they're forgotten all at once when it's reached,
+// so languages from user input (e.g. Accept-Language) can't grow memory without bound
+const maxMissingLangs = 1024
+
+// ensureLoaded read language on first lookup in lazy mode, concurrent first lookups of a language read it only once.
+// languages which don't exist in any source are left to lookup to report, and remembered so they aren't checked on
+// filesystem again. only loaded languages are recorded for eviction.
+// templates referring another language can't trigger loading, since they are parsed while lookup holds the lock
+func (i *I18nFS) ensureLoaded(lang string) error {
+	if !i.Lazy || lang == "" {
+		return nil
+	}
+	if lang == i.CommonLang || i.HasLang(lang) {
+		i.touch(lang)
+		return nil
+	}
+	if i.isMissing(lang) {
+		return nil
+	}
+	_, err, _ := i.loadGroup.Do(lang, func() (any, error) {
+		if i.HasLang(lang) {
+			return nil, nil
+		}
+		if !i.IsLangExists(lang) {
+			i.markMissing(lang)
+			return nil, nil
+		}
+		return nil, i.Read(lang)
+	})
+	if err == nil && i.HasLang(lang) {
+		i.touch(lang)
+	}
+	return err
+}
+
+func (i *I18nFS) isMissing(lang string) bool {
+	i.missingLangs.Lock()
+	defer i.missingLangs.Unlock()
+	_, ok := i.missingLangs.langs[lang]
+	return ok
+}
+
+func (i *I18nFS) markMissing(lang string) {
+	i.missingLangs.Lock()
+	defer i.missingLangs.Unlock()
+	if i.missingLangs.langs == nil || len(i.missingLangs.langs) >= maxMissingLangs {
+		i.missingLangs.langs = make(map[string]struct{})
+	}
+	i.missingLangs.langs[lang] = struct{}{}
+}
+
+// touch record language is used now
+func (i *I18nFS) touch(lang string) {
+	now := time.Now().UnixNano()
+	if lastUsed, ok := i.lastUsed.Load(lang); ok {
+		lastUsed.(*atomic.Int64).Store(now)
+		return
+	}
+	lastUsed := &atomic.Int64{}
+	lastUsed.Store(now)
+	i.lastUsed.Store(lang, lastUsed)
+}
+
+// EvictIdle unload languages which aren't looked up for idle, except languages in keep, and returns them sorted.
+// only values read from sources are removed, so evicted languages are read again on next lookup in lazy mode,
+// and messages added at runtime are kept. languages which only have runtime messages are never evicted
+func (i *I18nFS) EvictIdle(idle time.Duration, keep ...string) (evicted []string) {
+	deadline := time.Now().Add(-idle).UnixNano()
+
+	i.mu.RLock()
+	var candidates []string
+	for lang := range i.langStringMaps {
+		if !slices.Contains(keep, lang) && i.isIdle(lang, deadline) {
+			candidates = append(candidates, lang)
+		}
+	}
+	i.mu.RUnlock()
+	// filesystem is checked without lock, so lookups aren't blocked by it
+	candidates = slices.DeleteFunc(candidates, func(lang string) bool {
+		return !i.IsLangExists(lang)
+	})
+	if len(candidates) == 0 {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, lang := range candidates {
+		// it may be looked up or evicted meanwhile
+		if !i.hasLang(lang) || !i.isIdle(lang, deadline) {
+			continue
+		}
+		for _, source := range i.sources() {
+			delete(source.langStringMaps, lang)
+			delete(source.langOverrides, lang)
+		}
+		i.lastUsed.Delete(lang)
+		evicted = append(evicted, lang)
+	}
+	slices.Sort(evicted)
+	return
+}
+
+// isIdle check language isn't looked up since deadline
+func (i *I18nFS) isIdle(lang string, deadline int64) bool {
+	lastUsed, ok := i.lastUsed.Load(lang)
+	return !ok || lastUsed.(*atomic.Int64).Load() <= deadline
+}
@@ -0,0 +1,81 @@
+package structs
+
+import (
+	"fmt"
+	"github.com/hanakogo/i18n/internal/utils"
+	"slices"
+)
+
+// AddMessages merge messages into the runtime layer of language, which is above values of all sources.
+// keys of messages are paths without indexes, values of type map[string]any are merged deeply.
+// language is loaded by it if it isn't, even if it has no directory in any source
+func (i *I18nFS) AddMessages(lang string, messages map[string]any) error {
+	if lang == "" {
+		return fmt.Errorf("language of messages is empty")
+	}
+
+	type message struct {
+		keys  []string
+		value any
+	}
+	var flattened []message
+	for path, value := range messages {
+		nodes, err := utils.ParsePath(path)
+		if err != nil {
+			return err
+		}
+		keys := make([]string, len(nodes))
+		for idx, node := range nodes {
+			if len(node.Indexes) > 0 {
+				return fmt.Errorf("path[%s] of message can't contain index", path)
+			}
+			keys[idx] = node.Key
+		}
+		if valueMap, ok := value.(map[string]any); ok {
+			utils.WalkStringMap(valueMap, func(value any, path []string) {
+				flattened = append(flattened, message{keys: append(keys[:len(keys):len(keys)], path...), value: value})
+			})
+			continue
+		}
+		flattened = append(flattened, message{keys: keys, value: value})
+	}
+	// iteration of map is in random order, sort it so "a" is always set before "a.b"
+	slices.SortFunc(flattened, func(a, b message) int {
+		return slices.Compare(a.keys, b.keys)
+	})
+	// in lazy mode, values of language on disk must be read first, otherwise it's regarded as loaded below
+	if err := i.ensureLoaded(lang); err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	// values which are got before may still be read without lock, so the map is copied instead of changed in place
+	runtimeMap := utils.CloneStringMap(i.runtimeMaps[lang])
+	for _, msg := range flattened {
+		utils.SetStringMap(runtimeMap, msg.keys, msg.value)
+	}
+	i.runtimeMaps[lang] = runtimeMap
+	if !i.hasLang(lang) {
+		i.langStringMaps[lang] = make(map[string]any)
+	}
+	return nil
+}
+
+// LoadValues load values generated in memory as language of this source (e.g. pseudo-locales),
+// values read or loaded before are replaced, messages added at runtime are kept
+func (i *I18nFS) LoadValues(lang string, values map[string]any) error {
+	if lang == "" {
+		return fmt.Errorf("language of values is empty")
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, overlay := range i.overlays {
+		delete(overlay.langStringMaps, lang)
+		delete(overlay.langOverrides, lang)
+	}
+	i.langStringMaps[lang] = values
+	delete(i.langOverrides, lang)
+	return nil
+}
@@ -1,26 +1,41 @@
 package structs
 
 import (
+	"fmt"
 	"github.com/gookit/goutil"
-	"github.com/gookit/goutil/strutil"
 	"github.com/hanakogo/i18n/internal/utils"
+	"slices"
 )
 
 const DefaultTemplatePlaceholder = "<NotFound>"
 
+// MaxTemplateDepth templates nested deeper than it are treated as not found
+const MaxTemplateDepth = 32
+
 // ParseTemplateString parse path in template to refer others string
-// format of template like "${path}"
+// format of template like "${path}" or "${lang:path}", path can contain quoted keys like `${errors."v1.2"}`
 func (i *I18nFS) ParseTemplateString(stringVal string, lang string) any {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parseTemplateString(stringVal, lang, nil)
+}
+
+// parseTemplateString template which refers one of referrers is a reference cycle, it's treated as not found
+func (i *I18nFS) parseTemplateString(stringVal string, lang string, referrers []string) any {
 	return utils.ParseTemplates(stringVal, func(template string) string {
 		// specific language in template
-		if strutil.IContains(template, ":") {
-			lang = strutil.Split(template, ":")[0]
-			template = strutil.Split(template, ":")[1]
+		templateLang, template := utils.SplitTemplateLang(template)
+		if templateLang == "" {
+			templateLang = lang
+		}
+		if templateLang == "" || template == "" {
+			return DefaultTemplatePlaceholder
 		}
-		if lang == "" || template == "" {
+		referrer := fmt.Sprintf("%s:%s", templateLang, template)
+		if len(referrers) >= MaxTemplateDepth || slices.Contains(referrers, referrer) {
 			return DefaultTemplatePlaceholder
 		}
-		parsedTempVal, err := i.GetValByPath(lang, template)
+		parsedTempVal, err := i.getValByPath(templateLang, template, append(referrers[:len(referrers):len(referrers)], referrer))
 		if err != nil {
 			return DefaultTemplatePlaceholder
 		}
@@ -6,46 +6,115 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 )
 
-// WalkLangYAML walk all yaml file of language, and process content of them with walkFunc
-func (i *I18nFS) WalkLangYAML(lang string, walkFunc func(content string)) error {
-	langDir := i.filePathJoin(i.FSPrefix, lang)
-	switch i.FsMode {
-	case i18nfs.ModeEmbed:
-		dirEntries, err := i.LangFSEmbed.ReadDir(langDir)
+// WalkLangYAML walk all yaml file of language in lexical order of their paths, and process content of them with
+// walkFunc, walking is stopped once walkFunc returns an error
+func (i *I18nFS) WalkLangYAML(lang string, walkFunc func(file string, content []byte) error) error {
+	files, err := i.listLangYAML(lang)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		var bytes []byte
+		switch i.FsMode {
+		case i18nfs.ModeEmbed:
+			bytes, err = i.LangFSEmbed.ReadFile(file)
+		case i18nfs.ModeFileSystem:
+			bytes, err = os.ReadFile(file)
+		}
 		if err != nil {
 			return err
 		}
-		for _, entry := range dirEntries {
-			if !utils.CheckYaml(entry.Name()) {
-				continue
-			}
-			langFile := i.filePathJoin(langDir, entry.Name())
-			bytes, err := i.LangFSEmbed.ReadFile(langFile)
+		if err = walkFunc(file, bytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listLangYAML list yaml files of language, sorted so merging result doesn't depend on directory listing
+func (i *I18nFS) listLangYAML(lang string) (files []string, err error) {
+	langDir := i.filePathJoin(i.FSPrefix, lang)
+	switch i.FsMode {
+	case i18nfs.ModeEmbed:
+		// embed.FS always uses "/", so it's walked by fs.WalkDir instead of filepath.WalkDir
+		err = fs.WalkDir(i.LangFSEmbed, langDir, func(path string, entry fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
-			walkFunc(string(bytes))
+			if entry.IsDir() || !utils.CheckYaml(entry.Name()) {
+				return nil
+			}
+			files = append(files, path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	case i18nfs.ModeFileSystem:
-		err := filepath.WalkDir(langDir, func(path string, entry fs.DirEntry, err error) error {
+		err = filepath.WalkDir(langDir, func(path string, entry fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
-			if !utils.CheckYaml(entry.Name()) {
+			if entry.IsDir() || !utils.CheckYaml(entry.Name()) {
 				return nil
 			}
-			bytes, err := os.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			walkFunc(string(bytes))
+			files = append(files, path)
 			return nil
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	slices.Sort(files)
+	return files, nil
+}
+
+// FileNamespace keys which values of file are put under if Namespaced is enabled,
+// e.g. "billing/invoice.yaml" of language is ["billing", "invoice"]
+func (i *I18nFS) FileNamespace(lang string, file string) []string {
+	if !i.Namespaced {
+		return nil
+	}
+	langDir := i.filePathJoin(i.FSPrefix, lang)
+	rel, err := filepath.Rel(langDir, file)
+	if err != nil {
+		return nil
+	}
+	rel = strings.TrimSuffix(filepath.ToSlash(rel), filepath.Ext(rel))
+	return strings.Split(rel, "/")
+}
+
+// LangDir a directory of language under FSPrefix of a source
+type LangDir struct {
+	Source string
+	Name   string
+}
+
+// ListLangDirs list directories under FSPrefix of all sources except CommonLang, sorted by source then name
+func (i *I18nFS) ListLangDirs() ([]LangDir, error) {
+	var dirs []LangDir
+	for _, source := range i.sources() {
+		var entries []fs.DirEntry
+		var err error
+		switch source.FsMode {
+		case i18nfs.ModeEmbed:
+			entries, err = source.LangFSEmbed.ReadDir(source.FSPrefix)
+		case i18nfs.ModeFileSystem:
+			entries, err = os.ReadDir(source.FSPrefix)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || entry.Name() == i.CommonLang {
+				continue
+			}
+			dirs = append(dirs, LangDir{Source: source.Name, Name: entry.Name()})
+		}
+	}
+	return dirs, nil
 }
@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatVerb is a verb of printf format, e.g. "%-5.2f" or "%[2]*[1]d"
+type FormatVerb struct {
+	Raw  string
+	Verb rune
+	// ArgIndex index (0-based) of argument which is formatted by verb
+	ArgIndex int
+	// StarArgIndexes indexes of arguments which are consumed by "*" of width or precision, they must be int
+	StarArgIndexes []int
+	// Indexed argument is specified by explicit index like "[2]"
+	Indexed bool
+}
+
+// ParseFormatVerbs parse all verbs of printf format in order, "%%" is skipped.
+// arguments are numbered in the same way as fmt, explicit index like "%[2]s" is supported
+func ParseFormatVerbs(s string) (verbs []FormatVerb) {
+	argNum := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			continue
+		}
+		start := i
+		i++
+		var starArgIndexes []int
+		indexed := false
+
+		// flags
+		for i < len(s) && (s[i] == '+' || s[i] == '-' || s[i] == '#' || s[i] == ' ' || s[i] == '0') {
+			i++
+		}
+		i, argNum, indexed = parseArgIndex(s, i, argNum)
+		// width
+		if i < len(s) && s[i] == '*' {
+			starArgIndexes = append(starArgIndexes, argNum)
+			argNum++
+			i++
+		} else {
+			for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+				i++
+			}
+		}
+		// precision
+		if i < len(s) && s[i] == '.' {
+			i++
+			i, argNum, indexed = parseArgIndex(s, i, argNum)
+			if i < len(s) && s[i] == '*' {
+				starArgIndexes = append(starArgIndexes, argNum)
+				argNum++
+				i++
+			} else {
+				for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+					i++
+				}
+			}
+		}
+		var verbIndexed bool
+		i, argNum, verbIndexed = parseArgIndex(s, i, argNum)
+		indexed = indexed || verbIndexed
+
+		if i >= len(s) {
+			break
+		}
+		verb, size := utf8.DecodeRuneInString(s[i:])
+		i += size - 1
+		if verb == '%' {
+			continue
+		}
+		verbs = append(verbs, FormatVerb{
+			Raw:            s[start : i+1],
+			Verb:           verb,
+			ArgIndex:       argNum,
+			StarArgIndexes: starArgIndexes,
+			Indexed:        indexed,
+		})
+		argNum++
+	}
+	return
+}
+
+// parseArgIndex parse explicit argument index like "[2]" at i, argNum is changed if it exists
+func parseArgIndex(s string, i int, argNum int) (int, int, bool) {
+	if i >= len(s) || s[i] != '[' {
+		return i, argNum, false
+	}
+	for j := i + 1; j < len(s); j++ {
+		if s[j] == ']' {
+			index, err := strconv.Atoi(s[i+1 : j])
+			if err != nil || index < 1 {
+				return j + 1, argNum, false
+			}
+			return j + 1, index - 1, true
+		}
+	}
+	return i, argNum, false
+}
+
+// FormatArgCount count of arguments which are needed by verbs
+func FormatArgCount(verbs []FormatVerb) (count int) {
+	for _, verb := range verbs {
+		count = max(count, verb.ArgIndex+1)
+		for _, starArgIndex := range verb.StarArgIndexes {
+			count = max(count, starArgIndex+1)
+		}
+	}
+	return
+}
+
+// kinds of argument which are accepted by verbs
+const (
+	kindInt = 1 << iota
+	kindFloat
+	kindString
+	kindBool
+	kindAny = kindInt | kindFloat | kindString | kindBool
+)
+
+func verbKinds(verb rune) int {
+	switch verb {
+	case 'd', 'c', 'U', 'o', 'O':
+		return kindInt
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		return kindFloat
+	case 'b':
+		return kindInt | kindFloat
+	case 's':
+		return kindString
+	case 'q':
+		return kindString | kindInt
+	case 'x', 'X':
+		return kindInt | kindFloat | kindString
+	case 't':
+		return kindBool
+	}
+	// %v, %T, %p and unknown verbs
+	return kindAny
+}
+
+// argUse kinds which are accepted by all verbs of an argument
+type argUse struct {
+	kinds int
+	raws  []string
+}
+
+func argUses(verbs []FormatVerb) []argUse {
+	uses := make([]argUse, FormatArgCount(verbs))
+	for idx := range uses {
+		uses[idx].kinds = kindAny
+	}
+	for _, verb := range verbs {
+		for _, starArgIndex := range verb.StarArgIndexes {
+			uses[starArgIndex].kinds &= kindInt
+			uses[starArgIndex].raws = append(uses[starArgIndex].raws, verb.Raw)
+		}
+		uses[verb.ArgIndex].kinds &= verbKinds(verb.Verb)
+		uses[verb.ArgIndex].raws = append(uses[verb.ArgIndex].raws, verb.Raw)
+	}
+	return uses
+}
+
+// CompareVerbs check verbs of target are compatible with verbs of base, so the same arguments can be passed to both.
+// arguments are matched by index, so order, flags, width and precision are allowed to be different,
+// target may skip arguments only if it uses explicit indexes like "%[2]s"
+func CompareVerbs(base, target []FormatVerb) error {
+	baseUses, targetUses := argUses(base), argUses(target)
+	if len(targetUses) > len(baseUses) {
+		return fmt.Errorf("needs %d argument(s), but only %d are given to base", len(targetUses), len(baseUses))
+	}
+	if len(targetUses) < len(baseUses) && !slices.ContainsFunc(target, func(verb FormatVerb) bool { return verb.Indexed }) {
+		return fmt.Errorf("uses %d argument(s), but %d are given to base, use explicit index like %%[n]s to skip arguments",
+			len(targetUses), len(baseUses))
+	}
+	for idx, targetUse := range targetUses {
+		baseUse := baseUses[idx]
+		if len(targetUse.raws) == 0 || len(baseUse.raws) == 0 {
+			continue
+		}
+		if targetUse.kinds&baseUse.kinds == 0 {
+			return fmt.Errorf("argument %d is formatted by %s, but by %s in base",
+				idx+1, strings.Join(targetUse.raws, ", "), strings.Join(baseUse.raws, ", "))
+		}
+	}
+	return nil
+}
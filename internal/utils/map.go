@@ -2,81 +2,208 @@ package utils
 
 import (
 	"fmt"
-	"github.com/gookit/goutil/maputil"
-	"github.com/gookit/goutil/mathutil"
-	"github.com/gookit/goutil/strutil"
-	"reflect"
-	"strings"
+	"github.com/hanakogo/i18n/i18nfs"
+	"os"
+	"slices"
 )
 
 // WalkStringMap deep walk map[string]any
 func WalkStringMap(stringMap map[string]any, walkFun func(value any, path []string), path ...string) {
 	for key, value := range stringMap {
+		// full slice expression makes append always copy, so siblings never share the same path
+		keyPath := append(path[:len(path):len(path)], key)
 		if value, ok := value.(map[string]any); ok {
-			WalkStringMap(value, walkFun, append(path, key)...)
+			WalkStringMap(value, walkFun, keyPath...)
 			continue
 		}
-		walkFun(value, append(path, key))
+		walkFun(value, keyPath)
 	}
 }
 
-// MergeStringMap deep merge map[string]any
-func MergeStringMap(dst *map[string]any, src map[string]any, lang string) (err error) {
+// Override a path which is defined by more than one file of a language, Winner is the file whose value is in effect
+type Override struct {
+	Path   string
+	Winner string
+	Loser  string
+}
+
+// StringMapMerger deep merge maps of files into Result, conflicts are resolved by Policy
+type StringMapMerger struct {
+	Lang      string
+	Policy    i18nfs.ConflictPolicy
+	Result    map[string]any
+	Overrides []Override
+	// origins file of each value, keyed by full path
+	origins map[string]string
+}
+
+func NewStringMapMerger(lang string, policy i18nfs.ConflictPolicy) *StringMapMerger {
+	return &StringMapMerger{
+		Lang:    lang,
+		Policy:  policy,
+		Result:  make(map[string]any),
+		origins: make(map[string]string),
+	}
+}
+
+// Merge merge src which is read from file into Result. a conflict is a path which already has a value,
+// or a path whose object is replaced by a value (and vice versa)
+func (m *StringMapMerger) Merge(src map[string]any, file string) (err error) {
+	var leaves [][]string
+	values := make(map[string]any)
 	WalkStringMap(src, func(value any, path []string) {
-		pathStr := strutil.Join(".", path...)
+		leaves = append(leaves, path)
+		values[FormatPath(path...)] = value
+	})
+	// walking of map is in random order, sort it so result of ConflictError is stable
+	slices.SortFunc(leaves, func(a, b []string) int {
+		return slices.Compare(a, b)
+	})
 
-		// check override of data
-		//if value, ok := maputil.GetByPath(pathStr, *dst); ok {
-		//	fmt.Printf("WARN: overriding existed data of language<%s>: {path: %s, value: %v}\n", lang, pathStr, value)
-		//}
+	for _, keys := range leaves {
+		path := FormatPath(keys...)
+		losers := m.conflicts(keys)
+		if len(losers) > 0 {
+			switch m.Policy {
+			case i18nfs.ConflictError:
+				return fmt.Errorf("path [%s] of language [%s] is defined by both %s and %s",
+					path, m.Lang, m.origins[losers[0]], file)
+			case i18nfs.ConflictFirstWins:
+				m.Overrides = append(m.Overrides, Override{Path: path, Winner: m.origins[losers[0]], Loser: file})
+				continue
+			}
+			for _, loser := range losers {
+				override := Override{Path: loser, Winner: file, Loser: m.origins[loser]}
+				if m.Policy == i18nfs.ConflictWarn {
+					_, _ = fmt.Fprintf(os.Stderr, "WARN: overriding existed data of language<%s>: {path: %s, winner: %s, loser: %s}\n",
+						m.Lang, override.Path, override.Winner, override.Loser)
+				}
+				m.Overrides = append(m.Overrides, override)
+				delete(m.origins, loser)
+			}
+		}
+		SetStringMap(m.Result, keys, values[path])
+		m.origins[path] = file
+	}
+	return
+}
 
-		err = maputil.SetByPath(dst, pathStr, value)
-		if err != nil {
-			return
+// conflicts full paths of existing values which conflict with keys, sorted
+func (m *StringMapMerger) conflicts(keys []string) (paths []string) {
+	for n := 1; n < len(keys); n++ {
+		value, ok := GetStringMap(m.Result, keys[:n])
+		if !ok {
+			return nil
+		}
+		if _, isMap := value.(map[string]any); !isMap {
+			return []string{FormatPath(keys[:n]...)}
 		}
+	}
+	value, ok := GetStringMap(m.Result, keys)
+	if !ok {
+		return nil
+	}
+	valueMap, isMap := value.(map[string]any)
+	if !isMap {
+		return []string{FormatPath(keys...)}
+	}
+	WalkStringMap(valueMap, func(_ any, path []string) {
+		paths = append(paths, FormatPath(append(keys[:len(keys):len(keys)], path...)...))
 	})
+	slices.Sort(paths)
 	return
 }
 
-// TakeStringMap take out the value from map[string]any
-func TakeStringMap(src *map[string]any, key string) (value any) {
-	var (
-		sliceIdx      int
-		sliceIdxValid bool
-	)
-	if lastOpenBracketIdx := strings.LastIndex(key, "["); strutil.IsEndOf(key, "]") && lastOpenBracketIdx > 0 {
-		idx := key[lastOpenBracketIdx+1 : len(key)-1]
-		key = key[:lastOpenBracketIdx]
-
-		err := fmt.Errorf("invalid index of key <%s[%s]>", key, idx)
-		if idx == "" {
-			return err
+// GetStringMap get value by raw keys, keys are never split by dot
+func GetStringMap(src map[string]any, keys []string) (value any, ok bool) {
+	for _, key := range keys[:len(keys)-1] {
+		if src, ok = src[key].(map[string]any); !ok {
+			return nil, false
 		}
+	}
+	value, ok = src[keys[len(keys)-1]]
+	return
+}
 
-		sliceIdxInt, errOfToInt := mathutil.ToInt(idx)
-		if errOfToInt != nil {
-			return err
+// SetStringMap set value by raw keys, keys are never split by dot,
+// missing nodes (or nodes which aren't a map) on the way will be replaced by map
+func SetStringMap(dst map[string]any, keys []string, value any) {
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := dst[key].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			dst[key] = next
 		}
+		dst = next
+	}
+	dst[keys[len(keys)-1]] = value
+}
 
-		sliceIdx = sliceIdxInt
-		sliceIdxValid = true
+// TakeStringMap take out the value of node from map[string]any, indexes of node are applied one by one,
+// negative index counts from the end of slice
+func TakeStringMap(src *map[string]any, node PathNode) (value any, err error) {
+	value, ok := (*src)[node.Key]
+	if !ok {
+		return nil, fmt.Errorf("key <%s> is not found", node.Key)
 	}
 
-	value = (*src)[key]
-	if value == nil {
-		return nil
+	for i, idx := range node.Indexes {
+		sliceVal, ok := value.([]any)
+		if !ok {
+			taken := PathNode{Key: node.Key, Indexes: node.Indexes[:i]}
+			return nil, fmt.Errorf("<%s> is not a slice, can't take index %d", taken, idx)
+		}
+		if idx < 0 {
+			idx += len(sliceVal)
+		}
+		if idx < 0 || idx >= len(sliceVal) {
+			return nil, fmt.Errorf(`index %d is outbound of slice "%s"`, node.Indexes[i], node.Key)
+		}
+		value = sliceVal[idx]
 	}
 
-	valueType := reflect.TypeOf(value)
-	if sliceIdxValid && valueType.Kind() == reflect.Slice {
-		if sliceVal, ok := value.([]any); ok {
-			if sliceIdx >= len(sliceVal) || sliceIdx < 0 {
-				panic(fmt.Errorf(`index %d is outbound of slice "%s"`, sliceIdx, key))
-			}
-			return sliceVal[sliceIdx]
+	return value, nil
+}
+
+// TakeStringMapByNodes walk all nodes and take out the value from map[string]any
+func TakeStringMapByNodes(src map[string]any, nodes []PathNode) (value any, err error) {
+	// walk all nodes of path, unless last one
+	for i, node := range nodes[:len(nodes)-1] {
+		value, err := TakeStringMap(&src, node)
+		if err != nil {
+			return nil, fmt.Errorf("destination path %s is invalid: %w", JoinPath(nodes, i), err)
 		}
-		return nil
+
+		// take out a Map, then continue
+		if value, ok := value.(map[string]any); ok {
+			src = value
+			continue
+		}
+
+		// can't take out a Map, so we can't continue to walk deeper structure
+		return nil, fmt.Errorf(
+			"destination path %s isn't point to a object, can't continue to get value",
+			JoinPath(nodes, i),
+		)
 	}
 
-	return value
+	// need some special handling for last one node
+	lastIdx := len(nodes) - 1
+	value, err = TakeStringMap(&src, nodes[lastIdx])
+	if err != nil {
+		return nil, fmt.Errorf("destination path %s is invalid: %w", JoinPath(nodes, lastIdx), err)
+	}
+	return value, nil
+}
+
+// CloneStringMap deep copy nested maps of src, other values (include slices) are shared
+func CloneStringMap(src map[string]any) map[string]any {
+	dst := make(map[string]any, len(src))
+	for key, value := range src {
+		if valueMap, ok := value.(map[string]any); ok {
+			value = CloneStringMap(valueMap)
+		}
+		dst[key] = value
+	}
+	return dst
 }
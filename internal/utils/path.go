@@ -0,0 +1,220 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/gookit/goutil/strutil"
+	pathutil "path"
+	"strconv"
+	"strings"
+)
+
+// PathNode is a node of path, like "list[0][-1]" (key "list" with indexes 0 and -1)
+type PathNode struct {
+	Key     string
+	Indexes []int
+}
+
+// String format node back to the form of path, key will be quoted if it's necessary
+func (n PathNode) String() string {
+	var builder strings.Builder
+	builder.WriteString(QuoteKey(n.Key))
+	for _, idx := range n.Indexes {
+		builder.WriteString(fmt.Sprintf("[%d]", idx))
+	}
+	return builder.String()
+}
+
+// QuoteKey quote key if it contains any char which has special meaning in path
+func QuoteKey(key string) string {
+	if key == "" || strings.ContainsAny(key, ".[]\"' \t\n\\${}:") {
+		return strconv.Quote(key)
+	}
+	return key
+}
+
+// FormatPath join raw keys as a string type path
+func FormatPath(keys ...string) string {
+	quotedKeys := make([]string, len(keys))
+	for idx, key := range keys {
+		quotedKeys[idx] = QuoteKey(key)
+	}
+	return strutil.Join(".", quotedKeys...)
+}
+
+// JoinPath join nodes as a string type path, node of markIdx will be wrapped by "<>" (-1 to mark nothing)
+func JoinPath(nodes []PathNode, markIdx int) string {
+	nodeStrings := make([]string, len(nodes))
+	for idx, node := range nodes {
+		nodeStrings[idx] = node.String()
+		if idx == markIdx {
+			nodeStrings[idx] = fmt.Sprintf("<%s>", nodeStrings[idx])
+		}
+	}
+	return strutil.Join(".", nodeStrings...)
+}
+
+// ParsePath parse string type path as nodes.
+//
+// nodes are separated by dot, key of node can be quoted by double quotes if it contains dots or spaces,
+// and can also be written in brackets, e.g. `errors."v1.2".title` or `messages["Hello world"]`
+func ParsePath(path string) (nodes []PathNode, err error) {
+	if path == "" {
+		return nil, fmt.Errorf("destination path is empty")
+	}
+
+	p := pathParser{path: path}
+	for {
+		node, err := p.parseNode()
+		if err != nil {
+			return nil, fmt.Errorf("destination path[%s] is invalid: %w", path, err)
+		}
+		nodes = append(nodes, node)
+
+		if p.eof() {
+			return nodes, nil
+		}
+		switch p.peek() {
+		case '.':
+			p.pos++
+		case '[':
+			// bracket-quoted key, start a new node without dot
+		default:
+			return nil, fmt.Errorf("destination path[%s] is invalid: unexpected char %q at %d", path, p.peek(), p.pos)
+		}
+	}
+}
+
+type pathParser struct {
+	path string
+	pos  int
+}
+
+func (p *pathParser) eof() bool {
+	return p.pos >= len(p.path)
+}
+
+func (p *pathParser) peek() byte {
+	return p.path[p.pos]
+}
+
+// parseNode parse a node like `key`, `"quoted.key"`, `["quoted key"]` or with indexes `key[0][-1]`
+func (p *pathParser) parseNode() (node PathNode, err error) {
+	if p.eof() {
+		return node, fmt.Errorf("contains empty node")
+	}
+
+	switch p.peek() {
+	case '"':
+		node.Key, err = p.parseQuoted()
+	case '[':
+		if p.pos+1 >= len(p.path) || p.path[p.pos+1] != '"' {
+			return node, fmt.Errorf("index at %d has no key", p.pos)
+		}
+		p.pos++
+		node.Key, err = p.parseQuoted()
+		if err == nil {
+			err = p.expect(']')
+		}
+	default:
+		node.Key, err = p.parseBare()
+	}
+	if err != nil {
+		return node, err
+	}
+
+	// indexes, a bracket followed by quote is a bracket-quoted key of next node
+	for !p.eof() && p.peek() == '[' {
+		if p.pos+1 < len(p.path) && p.path[p.pos+1] == '"' {
+			break
+		}
+		idx, err := p.parseIndex()
+		if err != nil {
+			return node, err
+		}
+		node.Indexes = append(node.Indexes, idx)
+	}
+	return node, nil
+}
+
+func (p *pathParser) parseBare() (string, error) {
+	start := p.pos
+	for !p.eof() {
+		switch c := p.peek(); c {
+		case '.', '[':
+			if p.pos == start {
+				return "", fmt.Errorf("contains empty node")
+			}
+			return p.path[start:p.pos], nil
+		case ' ', '"', ']':
+			return "", fmt.Errorf("contains invaild char %q at %d, quote the key to use it", c, p.pos)
+		}
+		p.pos++
+	}
+	return p.path[start:], nil
+}
+
+func (p *pathParser) parseQuoted() (string, error) {
+	start := p.pos
+	p.pos++
+	for !p.eof() {
+		switch p.peek() {
+		case '\\':
+			p.pos += 2
+			continue
+		case '"':
+			p.pos++
+			key, err := strconv.Unquote(p.path[start:p.pos])
+			if err != nil {
+				return "", fmt.Errorf("invalid quoted key %s", p.path[start:p.pos])
+			}
+			return key, nil
+		}
+		p.pos++
+	}
+	return "", fmt.Errorf("unclosed quote at %d", start)
+}
+
+func (p *pathParser) parseIndex() (int, error) {
+	start := p.pos
+	closeBracketIdx := strings.IndexByte(p.path[start:], ']')
+	if closeBracketIdx == -1 {
+		return 0, fmt.Errorf("unclosed bracket at %d", start)
+	}
+	p.pos += closeBracketIdx + 1
+	idx, err := strconv.Atoi(p.path[start+1 : start+closeBracketIdx])
+	if err != nil {
+		return 0, fmt.Errorf("invalid index %s", p.path[start:p.pos])
+	}
+	return idx, nil
+}
+
+func (p *pathParser) expect(c byte) error {
+	if p.eof() || p.peek() != c {
+		return fmt.Errorf("expect %q at %d", c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+// MatchPath check raw keys of a path match patterns one by one,
+// pattern is a glob of path.Match, and "**" matches zero or more keys
+func MatchPath(patterns []string, keys []string) bool {
+	if len(patterns) == 0 {
+		return len(keys) == 0
+	}
+	if patterns[0] == "**" {
+		for n := 0; n <= len(keys); n++ {
+			if MatchPath(patterns[1:], keys[n:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(keys) == 0 {
+		return false
+	}
+	if ok, err := pathutil.Match(patterns[0], keys[0]); err != nil || !ok {
+		return false
+	}
+	return MatchPath(patterns[1:], keys[1:])
+}
@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Placeholder is a placeholder of message like "{count}" or "{count, number, compact}"
+type Placeholder struct {
+	// Raw whole placeholder include braces
+	Raw   string
+	Name  string
+	Type  string
+	Style string
+}
+
+var placeholderRegexp = regexp.MustCompile(`\{\s*(\w+)\s*(?:,\s*(\w+)\s*(?:,\s*([^{}]*?)\s*)?)?}`)
+
+// placeholderAt placeholder at the beginning of s, empty if s doesn't start with a placeholder
+func placeholderAt(s string) string {
+	loc := placeholderRegexp.FindStringIndex(s)
+	if loc == nil || loc[0] != 0 {
+		return ""
+	}
+	return s[:loc[1]]
+}
+
+// ReplacePlaceholders replace all placeholders of s by result of replaceFunc, placeholder is kept if ok is false.
+// braces which don't form a placeholder and templates like "${path}" are kept as they are
+func ReplacePlaceholders(s string, replaceFunc func(placeholder Placeholder) (replacement string, ok bool)) string {
+	var builder strings.Builder
+	last := 0
+	for _, match := range placeholderRegexp.FindAllStringSubmatchIndex(s, -1) {
+		start, end := match[0], match[1]
+		if start > 0 && s[start-1] == '$' {
+			continue
+		}
+		group := func(n int) string {
+			if match[2*n] < 0 {
+				return ""
+			}
+			return s[match[2*n]:match[2*n+1]]
+		}
+		replacement, ok := replaceFunc(Placeholder{Raw: s[start:end], Name: group(1), Type: group(2), Style: group(3)})
+		if !ok {
+			continue
+		}
+		builder.WriteString(s[last:start])
+		builder.WriteString(replacement)
+		last = end
+	}
+	builder.WriteString(s[last:])
+	return builder.String()
+}
+
+// ParsePlaceholders all placeholders of s in order, templates like "${path}" are skipped
+func ParsePlaceholders(s string) (placeholders []Placeholder) {
+	ReplacePlaceholders(s, func(placeholder Placeholder) (string, bool) {
+		placeholders = append(placeholders, placeholder)
+		return "", false
+	})
+	return
+}
+
+// ComparePlaceholders check target uses the same placeholder names as base, and placeholders of the same name are
+// formatted by the same type. style is allowed to be different, and a placeholder without type matches any type
+func ComparePlaceholders(base, target []Placeholder) error {
+	baseTypes := placeholderTypes(base)
+	targetTypes := placeholderTypes(target)
+	for _, placeholder := range target {
+		baseType, ok := baseTypes[placeholder.Name]
+		if !ok {
+			return fmt.Errorf("placeholder %s isn't in base", placeholder.Raw)
+		}
+		if placeholder.Type != "" && baseType != "" && placeholder.Type != baseType {
+			return fmt.Errorf("placeholder {%s} is formatted as %s, but as %s in base",
+				placeholder.Name, placeholder.Type, baseType)
+		}
+	}
+	for _, placeholder := range base {
+		if _, ok := targetTypes[placeholder.Name]; !ok {
+			return fmt.Errorf("placeholder %s of base is missing", placeholder.Raw)
+		}
+	}
+	return nil
+}
+
+// placeholderTypes type of placeholders keyed by name, the first non-empty type wins
+func placeholderTypes(placeholders []Placeholder) map[string]string {
+	types := make(map[string]string, len(placeholders))
+	for _, placeholder := range placeholders {
+		if types[placeholder.Name] == "" {
+			types[placeholder.Name] = placeholder.Type
+		}
+	}
+	return types
+}
+
+// CompareFormats compare printf verbs and placeholders of target string with base string, see CompareVerbs and
+// ComparePlaceholders
+func CompareFormats(base, target string) error {
+	if err := CompareVerbs(ParseFormatVerbs(base), ParseFormatVerbs(target)); err != nil {
+		return err
+	}
+	return ComparePlaceholders(ParsePlaceholders(base), ParsePlaceholders(target))
+}
+
+// RawFormats raw strings of printf verbs followed by placeholders of s
+func RawFormats(s string) (raws []string) {
+	for _, verb := range ParseFormatVerbs(s) {
+		raws = append(raws, verb.Raw)
+	}
+	for _, placeholder := range ParsePlaceholders(s) {
+		raws = append(raws, placeholder.Raw)
+	}
+	return
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// rightToLeftOverride and popDirectionalFormatting force text between them to be displayed from right to left
+	rightToLeftOverride      = "\u202e"
+	popDirectionalFormatting = "\u202c"
+)
+
+var pseudoAccents = map[rune]rune{
+	'a': 'å', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î', 'j': 'ĵ',
+	'k': 'ķ', 'l': 'ļ', 'm': 'ɱ', 'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ', 's': 'š', 't': 'ţ',
+	'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+	'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Ð', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î', 'J': 'Ĵ',
+	'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ', 'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ', 'S': 'Š', 'T': 'Ţ',
+	'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+}
+
+// PseudoOpts how strings are pseudo-localized
+type PseudoOpts struct {
+	// Expansion ratio of extra length, e.g. 0.3 appends "~" of 30% length of text
+	Expansion float64
+	// Mirror display text from right to left
+	Mirror bool
+}
+
+// Pseudo pseudo-localize value, strings in lists and objects are pseudo-localized too, other values are kept
+func Pseudo(value any, opts PseudoOpts) any {
+	switch value := value.(type) {
+	case string:
+		return PseudoString(value, opts)
+	case []any:
+		list := make([]any, len(value))
+		for idx, elem := range value {
+			list[idx] = Pseudo(elem, opts)
+		}
+		return list
+	case map[string]any:
+		stringMap := make(map[string]any, len(value))
+		for key, elem := range value {
+			stringMap[key] = Pseudo(elem, opts)
+		}
+		return stringMap
+	default:
+		return value
+	}
+}
+
+// PseudoString accent letters of s, expand and wrap it by brackets, e.g. "Hello %s" to "[Ĥéļļö %s ~~]".
+// templates like "${path}", placeholders like "{count, number}" and printf verbs are kept untouched
+func PseudoString(s string, opts PseudoOpts) string {
+	if s == "" {
+		return s
+	}
+
+	var builder strings.Builder
+	builder.WriteString("[")
+	textLen := 0
+	writeText := func(text string) {
+		if text == "" {
+			return
+		}
+		textLen += utf8.RuneCountInString(text)
+		if opts.Mirror {
+			builder.WriteString(rightToLeftOverride)
+		}
+		for _, r := range text {
+			if accent, ok := pseudoAccents[r]; ok {
+				r = accent
+			}
+			builder.WriteRune(r)
+		}
+		if opts.Mirror {
+			builder.WriteString(popDirectionalFormatting)
+		}
+	}
+
+	verbs := ParseFormatVerbs(s)
+	start := 0
+	for i := 0; i < len(s); {
+		var token string
+		switch {
+		case strings.HasPrefix(s[i:], "${") && strings.Contains(s[i:], "}"):
+			token = s[i : i+strings.Index(s[i:], "}")+1]
+		case s[i] == '{' && placeholderAt(s[i:]) != "":
+			token = placeholderAt(s[i:])
+		case strings.HasPrefix(s[i:], "%%"):
+			token = "%%"
+		case s[i] == '%' && len(verbs) > 0 && strings.HasPrefix(s[i:], verbs[0].Raw):
+			token = verbs[0].Raw
+			verbs = verbs[1:]
+		default:
+			i++
+			continue
+		}
+		writeText(s[start:i])
+		builder.WriteString(token)
+		i += len(token)
+		start = i
+	}
+	writeText(s[start:])
+
+	if padding := int(math.Ceil(float64(textLen) * opts.Expansion)); padding > 0 {
+		builder.WriteString(" ")
+		builder.WriteString(strings.Repeat("~", padding))
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"fmt"
-	"github.com/gookit/goutil/strutil"
 	"strings"
 )
 
@@ -76,24 +75,12 @@ func FindFirstTemplate(s string) (template string, exists bool, end int) {
 	return
 }
 
-// ParsePath parse string type path as slice
-func ParsePath(path string) (paths []string, err error) {
-	if strutil.IContains(path, " ") {
-		err = fmt.Errorf(
-			"destination path[%s] contains invaild space",
-			strutil.Join(".", paths...),
-		)
-		return
+// SplitTemplateLang split template like "lang:path" into language and path,
+// lang is empty if template doesn't specify a language, colon in quoted key of path is ignored
+func SplitTemplateLang(template string) (lang string, path string) {
+	colonIdx := strings.Index(template, ":")
+	if colonIdx == -1 || strings.ContainsAny(template[:colonIdx], ".[\"") {
+		return "", template
 	}
-	paths = strutil.Split(path, ".")
-	for _, p := range paths {
-		if p == "" {
-			err = fmt.Errorf(
-				"destination path[%s] contains empty node",
-				strutil.Join(".", paths...),
-			)
-			return
-		}
-	}
-	return paths, nil
+	return template[:colonIdx], template[colonIdx+1:]
 }
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"github.com/hanakogo/i18n/i18nanalysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"testing"
+)
+
+func TestAnalyzer(t *testing.T) {
+	// catalog is located by .i18n.yaml in package directory
+	analysistest.Run(t, analysistest.TestData()+"/analysis", i18nanalysis.Analyzer, "a")
+	// catalog of .i18n.yaml is namespaced
+	analysistest.Run(t, analysistest.TestData()+"/analysis", i18nanalysis.Analyzer, "ns")
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nextract"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/i18ngen"
+	"github.com/hanakogo/i18n/i18nlint"
+	"path/filepath"
+	"testing"
+)
+
+func TestConflictPolicy(t *testing.T) {
+	as := assert.New(t)
+	initConflict := func(policy i18nfs.ConflictPolicy) error {
+		return initTestdata(t, "./testdata/conflict", i18n.Opts{Languages: []string{"en"}, ConflictPolicy: policy})
+	}
+
+	fileA := filepath.Join("testdata", "conflict", "en", "a.yaml")
+	fileB := filepath.Join("testdata", "conflict", "en", "b.yaml")
+	fileC := filepath.Join("testdata", "conflict", "en", "sub", "c.yaml")
+
+	// files are merged in lexical order, so sub/c.yaml is the last one
+	as.Nil(initConflict(i18nfs.ConflictLastWins))
+	as.Eq("c", i18n.GetString("main.title"))
+	as.Eq("b", i18n.GetString("main.obj"))
+	as.Eq("a", i18n.GetString("main.onlyA"))
+	as.Eq([]i18n.Override{
+		{Path: "main.obj.x", Winner: fileB, Loser: fileA},
+		{Path: "main.title", Winner: fileB, Loser: fileA},
+		{Path: "main.title", Winner: fileC, Loser: fileB},
+	}, i18n.Overrides("en"))
+
+	as.Nil(initConflict(i18nfs.ConflictFirstWins))
+	as.Eq("a", i18n.GetString("main.title"))
+	as.Eq("a", i18n.GetString("main.obj.x"))
+	as.Eq([]i18n.Override{
+		{Path: "main.obj", Winner: fileA, Loser: fileB},
+		{Path: "main.title", Winner: fileA, Loser: fileB},
+		{Path: "main.title", Winner: fileA, Loser: fileC},
+	}, i18n.Overrides("en"))
+
+	as.Nil(initConflict(i18nfs.ConflictWarn))
+	as.Eq("c", i18n.GetString("main.title"))
+	as.Eq(3, len(i18n.Overrides("en")))
+
+	err := initConflict(i18nfs.ConflictError)
+	as.NotNil(err)
+	as.Eq("path [main.obj] of language [en] is defined by both "+fileA+" and "+fileB, err.Error())
+
+	// no conflict
+	TestLoadEmbed(t)
+	as.Eq(0, len(i18n.Overrides("zh-CN")))
+}
+
+func TestConflictPolicyTools(t *testing.T) {
+	as := assert.New(t)
+	dir := "./testdata/conflict"
+
+	// lint reports the ignored values of first-wins at the files defining them
+	diagnostics, err := i18nlint.Lint(i18nlint.Opts{Dir: dir, DefaultLang: "en", ConflictPolicy: i18nfs.ConflictFirstWins})
+	as.Nil(err)
+	var files []string
+	for _, diagnostic := range diagnostics {
+		as.Eq(i18nlint.RuleOverride, diagnostic.Rule)
+		as.StrContains(diagnostic.Message, "is ignored")
+		files = append(files, diagnostic.File)
+	}
+	as.Eq([]string{
+		filepath.Join("testdata", "conflict", "en", "b.yaml"),
+		filepath.Join("testdata", "conflict", "en", "b.yaml"),
+		filepath.Join("testdata", "conflict", "en", "sub", "c.yaml"),
+	}, files)
+
+	// generator documents the values used at runtime
+	source, err := i18ngen.Generate(i18ngen.Opts{Dir: dir, Lang: "en", Package: "msgs", ConflictPolicy: i18nfs.ConflictFirstWins})
+	as.Nil(err)
+	as.StrContains(string(source), `// Title main.title: "a"`)
+	as.StrContains(string(source), `i18n.GetStringTr(lang, "main.obj.x")`)
+	source, err = i18ngen.Generate(i18ngen.Opts{Dir: dir, Lang: "en", Package: "msgs"})
+	as.Nil(err)
+	as.StrContains(string(source), `// Title main.title: "c"`)
+	as.NotContains(string(source), `"main.obj.x"`)
+
+	// so does extract report
+	usages := []i18nextract.Usage{{Func: "GetString", Path: "main.obj.x"}}
+	report, err := i18nextract.Check(usages, dir, "en", i18nextract.CheckOpts{ConflictPolicy: i18nfs.ConflictFirstWins})
+	as.Nil(err)
+	as.Eq(0, len(report.Undefined))
+	report, err = i18nextract.Check(usages, dir, "en")
+	as.Nil(err)
+	as.Eq(1, len(report.Undefined))
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFormatCurrency(t *testing.T) {
+	as := assert.New(t)
+
+	format := func(lang string, value any, code string, opts ...i18n.CurrencyOpts) string {
+		result, err := i18n.FormatCurrency(lang, value, code, opts...)
+		as.Nil(err)
+		return result
+	}
+
+	// placement of symbol
+	as.Eq("$1,234.56", format("en-US", 1234.56, "USD"))
+	as.Eq("€1,234.56", format("en", 1234.56, "EUR"))
+	as.Eq("1.234,56\u00a0€", format("de", 1234.56, "EUR"))
+	as.Eq("EUR\u00a01’234.56", format("de-CH", 1234.56, "EUR"))
+	as.Eq("1\u00a0234,56\u00a0$US", format("fr", 1234.56, "USD"))
+	as.Eq("R$\u00a01.234,56", format("pt-BR", 1234.56, "BRL"))
+	// letters of symbol are separated from number
+	as.Eq("CHF\u00a01,234.56", format("en", 1234.56, "CHF"))
+
+	// digits of minor units
+	as.Eq("￥1,235", format("ja", 1234.6, "JPY"))
+	as.Eq("BHD\u00a01,234.500", format("en", 1234.5, "BHD"))
+	as.Eq("$5.00", format("en", 5, "USD"))
+	as.Eq("$1,234.57", format("en", 1234.567, "USD"))
+
+	// minor units
+	as.Eq("$1,234.56", format("en", 123456, "USD", i18n.CurrencyOpts{MinorUnits: true}))
+	as.Eq("BHD\u00a0123.456", format("en", 123456, "BHD", i18n.CurrencyOpts{MinorUnits: true}))
+	as.Eq("¥123,456", format("en", 123456, "JPY", i18n.CurrencyOpts{MinorUnits: true}))
+
+	// exact amounts
+	as.Eq("$1,234,567,890,123,456.78", format("en", int64(123456789012345678), "USD", i18n.CurrencyOpts{MinorUnits: true}))
+	as.Eq("$92,233,720,368,547,758.07", format("en", big.NewInt(math.MaxInt64), "USD", i18n.CurrencyOpts{MinorUnits: true}))
+	as.Eq("-1.234.567.890.123.456,78\u00a0€", format("de", "-1234567890123456.785", "EUR"))
+	as.Eq("€0.33", format("en", big.NewRat(1, 3), "EUR"))
+	as.Eq("$0.10", format("en", new(big.Float).SetFloat64(0.1), "USD"))
+	as.Eq("₹1,23,45,678.90", format("hi", 12345678.9, "INR"))
+	// floats are taken as their shortest decimal, then rounded half to even
+	as.Eq("$2.68", format("en", 2.675, "USD"))
+	as.Eq("$2.66", format("en", 2.665, "USD"))
+	as.Eq("$12,345,678,901,234,567.88", format("en", "12345678901234567.885", "USD"))
+	as.Eq("$0.00", format("en", -0.001, "USD"))
+
+	// sign is in front of symbol
+	as.Eq("-$1,234.56", format("en", -1234.56, "USD"))
+	as.Eq("-1.234,56\u00a0€", format("de", -1234.56, "EUR"))
+
+	// display
+	as.Eq("USD\u00a01,234.56", format("en", 1234.56, "USD", i18n.CurrencyOpts{Display: i18n.CurrencyCode}))
+	as.Eq("1.234,56\u00a0USD", format("de", 1234.56, "USD", i18n.CurrencyOpts{Display: i18n.CurrencyCode}))
+	as.Eq("1\u00a0234,56\u00a0$", format("fr", 1234.56, "USD", i18n.CurrencyOpts{Display: i18n.CurrencyNarrowSymbol}))
+
+	_, err := i18n.FormatCurrency("en", 5, "ABC")
+	as.Err(err)
+	_, err = i18n.FormatCurrency("en", "five", "USD")
+	as.Err(err)
+	_, err = i18n.FormatCurrency("en", math.NaN(), "USD")
+	as.Err(err)
+	_, err = i18n.FormatCurrency("en", "1e30", "USD")
+	as.Err(err)
+}
+
+func TestFormatCurrencyMessage(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/format", formatOpts))
+
+	as.Eq("Kontostand: 1.234,56\u00a0€", i18n.GetMessage("stats.balance", i18n.Args{"amount": 1234.56}))
+	as.Eq("Balance: $1,234.56", i18n.GetMessageTr("en", "stats.balance", i18n.Args{"amount": 1234.56}))
+	as.Eq("Gebühr: 1,99\u00a0€", i18n.GetMessage("stats.fee", i18n.Args{"cents": 199}))
+	as.Eq("USD\u00a01.99", i18n.FormatMessage("en", "{c, currency, USD code minor}", i18n.Args{"c": 199}))
+	as.Eq("$0.30", i18n.FormatMessage("en", "{p, currency, USD}", i18n.Args{"p": "0.30"}))
+	// unknown currency, option and amounts which aren't numbers are kept
+	as.Eq("{p, currency, ABC}", i18n.FormatMessage("en", "{p, currency, ABC}", i18n.Args{"p": 1}))
+	as.Eq("{p, currency, USD bad}", i18n.FormatMessage("en", "{p, currency, USD bad}", i18n.Args{"p": 1}))
+	as.Eq("{p, currency, USD}", i18n.FormatMessage("en", "{p, currency, USD}", i18n.Args{"p": "free"}))
+}
@@ -0,0 +1,104 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+	"time"
+)
+
+var dateTime = time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC)
+
+// mustFormat wrap format of dates, error fails the test
+func mustFormat(
+	t *testing.T, format func(string, time.Time, i18n.DateStyle) (string, error),
+) func(string, time.Time, i18n.DateStyle) string {
+	return func(lang string, value time.Time, style i18n.DateStyle) string {
+		t.Helper()
+		result, err := format(lang, value, style)
+		assert.Nil(t, err)
+		return result
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	as := assert.New(t)
+	formatDate := mustFormat(t, i18n.FormatDate)
+
+	as.Eq("3/5/24", formatDate("en", dateTime, i18n.DateShort))
+	as.Eq("Mar 5, 2024", formatDate("en", dateTime, i18n.DateMedium))
+	as.Eq("March 5, 2024", formatDate("en", dateTime, i18n.DateLong))
+	as.Eq("Tuesday, March 5, 2024", formatDate("en", dateTime, i18n.DateFull))
+	as.Eq("05/03/2024", formatDate("en-GB", dateTime, i18n.DateShort))
+	as.Eq("05.03.2024", formatDate("de", dateTime, i18n.DateMedium))
+	as.Eq("Dienstag, 5. März 2024", formatDate("de", dateTime, i18n.DateFull))
+	as.Eq("martes, 5 de marzo de 2024", formatDate("es", dateTime, i18n.DateFull))
+	as.Eq("2024年3月5日火曜日", formatDate("ja", dateTime, i18n.DateFull))
+	// empty style is medium
+	as.Eq("2024年3月5日", formatDate("zh", dateTime, ""))
+	as.Eq("Mar 5, 2024", formatDate("en-US", dateTime, ""))
+
+	// skeletons
+	as.Eq("5. März 2024", formatDate("de", dateTime, "yMMMd"))
+	as.Eq("mar. 5 mars 2024", formatDate("fr", dateTime, "yMMMEd"))
+	as.Eq("5 de marzo", formatDate("es", dateTime, "MMMMd"))
+
+	// languages without data and unknown skeletons are errors
+	_, err := i18n.FormatDate("ko", dateTime, i18n.DateMedium)
+	as.ErrMsg(err, "language [ko] has no data of dates")
+	_, err = i18n.FormatDate("xx", dateTime, i18n.DateMedium)
+	as.Err(err)
+	// data of simplified chinese isn't used for traditional chinese
+	_, err = i18n.FormatDate("zh-TW", dateTime, i18n.DateMedium)
+	as.Err(err)
+	_, err = i18n.FormatDate("de", dateTime, "yMMMMMd")
+	as.ErrMsg(err, "skeleton [yMMMMMd] is not supported by language [de]")
+	_, err = i18n.FormatDateTime("en", dateTime, "GGGGyQQQ")
+	as.Err(err)
+	as.Eq([]string{"de", "en", "en-GB", "es", "fr", "ja", "zh"}, i18n.DateLanguages())
+}
+
+func TestFormatTime(t *testing.T) {
+	as := assert.New(t)
+	formatTime, formatDateTime := mustFormat(t, i18n.FormatTime), mustFormat(t, i18n.FormatDateTime)
+
+	as.Eq("2:07 PM", formatTime("en", dateTime, i18n.DateShort))
+	as.Eq("2:07:09 PM UTC", formatTime("en", dateTime, i18n.DateLong))
+	as.Eq("14:07", formatTime("de", dateTime, i18n.DateShort))
+	as.Eq("14時07分09秒 UTC", formatTime("ja", dateTime, i18n.DateFull))
+	as.Eq("Mar 5, 2024, 2:07:09 PM", formatDateTime("en", dateTime, i18n.DateMedium))
+	as.Eq("5. März 2024 um 14:07:09", formatDateTime("de", dateTime, i18n.DateLong))
+	as.Eq("05/03/2024 14:07", formatDateTime("fr", dateTime, i18n.DateShort))
+
+	// hours preferred by language
+	as.Eq("2:07 PM", formatTime("en", dateTime, "jm"))
+	as.Eq("14:07", formatTime("de", dateTime, "jm"))
+	as.Eq("14:07", formatTime("en", dateTime, "Hm"))
+
+	// hour cycle of tag
+	as.Eq("14:07", formatTime("en-u-hc-h23", dateTime, i18n.DateShort))
+	as.Eq("Mar 5, 2024, 14:07:09", formatDateTime("en-u-hc-h23", dateTime, i18n.DateMedium))
+	as.Eq("2:07 PM", formatTime("de-u-hc-h12", dateTime, i18n.DateShort))
+	as.Eq("午後2:07", formatTime("ja-u-hc-h12", dateTime, "jm"))
+	// explicit hour of skeleton is kept
+	as.Eq("14:07", formatTime("de-u-hc-h12", dateTime, "Hm"))
+
+	// time zone of time
+	berlin := dateTime.In(time.FixedZone("CET", 3600))
+	as.Eq("15:07:09 CET", formatTime("de", berlin, i18n.DateLong))
+}
+
+func TestFormatDateMessage(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/format", formatOpts))
+
+	as.Eq("Termin am 5. März 2024 um 14:07", i18n.GetMessage("stats.meeting", i18n.Args{"when": dateTime}))
+	as.Eq("Meeting on March 5, 2024 at 2:07 PM",
+		i18n.GetMessageTr("en", "stats.meeting", i18n.Args{"when": dateTime}))
+	as.Eq("Aktualisiert: 5. März 2024", i18n.GetMessage("stats.updated", i18n.Args{"when": dateTime}))
+	as.Eq("Mar 5, 2024 14:07", i18n.FormatMessage("en", "{t, date} {t, time, Hm}", i18n.Args{"t": dateTime}))
+	// values which aren't time, languages without data and unknown skeletons are kept
+	as.Eq("{t, date, short}", i18n.FormatMessage("en", "{t, date, short}", i18n.Args{"t": "today"}))
+	as.Eq("{t, date, short}", i18n.FormatMessage("ko", "{t, date, short}", i18n.Args{"t": dateTime}))
+	as.Eq("{t, date, ::yMMMMMd}", i18n.FormatMessage("en", "{t, date, ::yMMMMMd}", i18n.Args{"t": dateTime}))
+}
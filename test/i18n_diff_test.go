@@ -0,0 +1,80 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	report, err := i18n.Diff("zh-CN", "en")
+	as.Nil(err)
+	as.Contains(report.Missing, "diff.zhOnly")
+	as.NotContains(report.Missing, "diff.obj.key")
+	as.Contains(report.Extra, "diff.enOnly")
+	as.Contains(report.TypeMismatches, i18n.TypeMismatch{
+		Path: "diff.typed", BaseType: "list", TargetType: "string",
+	})
+	as.Contains(report.TypeMismatches, i18n.TypeMismatch{
+		Path: "diff.obj", BaseType: "object", TargetType: "string",
+	})
+	as.Contains(report.PlaceholderMismatches, i18n.PlaceholderMismatch{
+		Path:               "diff.placeholder",
+		BasePlaceholders:   []string{"%d", "%s"},
+		TargetPlaceholders: []string{"%s", "%s"},
+		Reason:             "argument 1 is formatted by %s, but by %d in base",
+	})
+	// named placeholders are compared by name and type
+	as.Contains(report.PlaceholderMismatches, i18n.PlaceholderMismatch{
+		Path:               "diff.renamed",
+		BasePlaceholders:   []string{"{count}"},
+		TargetPlaceholders: []string{"{total}"},
+		Reason:             "placeholder {total} isn't in base",
+	})
+	as.Contains(report.PlaceholderMismatches, i18n.PlaceholderMismatch{
+		Path:               "diff.retyped",
+		BasePlaceholders:   []string{"{when, date}"},
+		TargetPlaceholders: []string{"{when, number}"},
+		Reason:             "placeholder {when} is formatted as number, but as date in base",
+	})
+	for _, mismatch := range report.PlaceholderMismatches {
+		// precision and style are allowed to be different
+		as.NotEq("diff.precision", mismatch.Path)
+		as.NotEq("diff.named", mismatch.Path)
+	}
+	as.False(report.Empty())
+
+	// reversed
+	report, err = i18n.Diff("en", "zh-CN")
+	as.Nil(err)
+	as.Contains(report.Missing, "diff.enOnly")
+	as.Contains(report.TypeMismatches, i18n.TypeMismatch{
+		Path: "diff.obj", BaseType: "string", TargetType: "object",
+	})
+
+	report, err = i18n.Diff("zh-CN", "zh-CN")
+	as.Nil(err)
+	as.True(report.Empty())
+
+	_, err = i18n.Diff("zh-CN", "not-exist")
+	as.NotNil(err)
+}
+
+func TestCoverage(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	coverage, err := i18n.Coverage("zh-CN")
+	as.Nil(err)
+	as.Eq(100.0, coverage)
+
+	coverage, err = i18n.Coverage("en")
+	as.Nil(err)
+	as.Gt(coverage, 0)
+	as.Lt(coverage, 100)
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"errors"
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nfs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiscoverLanguages(t *testing.T) {
+	as := assert.New(t)
+
+	// directory of common layer isn't a language
+	err := initTestdata(t, "./testdata/layers", i18n.Opts{
+		DefaultLang:       "zh-CN",
+		CommonLang:        "common",
+		DiscoverLanguages: true,
+	})
+	as.Nil(err)
+	as.True(i18n.Has("en"))
+	as.True(i18n.Has("zh-CN"))
+	as.False(i18n.Has("common"))
+
+	// languages of overlays are discovered too
+	err = initTestdata(t, "lang", i18n.Opts{
+		FSOpts:      i18n.FSOpts{FSMode: i18nfs.ModeEmbed, EmbedFS: &testdata},
+		DefaultLang: "zh-CN",
+		CommonLang:  "common",
+		Overlays: []i18n.FSOpts{
+			{FSMode: i18nfs.ModeFileSystem, Prefix: "./testdata/overlay/first"},
+		},
+		DiscoverLanguages: true,
+	})
+	as.Nil(err)
+	languages, err := i18n.DiscoverLanguages()
+	as.Nil(err)
+	as.Eq([]string{"en", "ja", "zh-CN"}, languages)
+	as.True(i18n.Has("ja"))
+}
+
+func TestDiscoverInvalidLanguages(t *testing.T) {
+	as := assert.New(t)
+
+	dir := t.TempDir()
+	for _, name := range []string{"en", "zh_CN", "xx", "i18n"} {
+		as.Nil(os.MkdirAll(filepath.Join(dir, name), 0o755))
+		as.Nil(os.WriteFile(filepath.Join(dir, name, "main.yaml"), []byte("str: value"), 0o644))
+	}
+
+	err := initTestdata(t, dir, i18n.Opts{DiscoverLanguages: true})
+	var discoverError *i18n.DiscoverError
+	as.True(errors.As(err, &discoverError))
+	var dirs []string
+	for _, invalid := range discoverError.Invalid {
+		as.Eq("base", invalid.Source)
+		dirs = append(dirs, invalid.Dir)
+	}
+	// every invalid directory is reported
+	as.Eq([]string{"i18n", "xx", "zh_CN"}, dirs)
+	as.Contains(err.Error(), "directory [zh_CN] of source [base]")
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/layers", layersOpts))
+	as.Nil(i18n.SetMessage("en", "app.enOnly", "English only <${brand.name}>"))
+
+	// values of the common layer are merged, templates are kept
+	content, err := i18n.Export("zh-CN", i18n.ExportYAML)
+	as.Nil(err)
+	as.Eq(`app:
+  refer: ${common:brand.name}
+  support: 'Contact: ${brand.url}'
+  title: ${brand.name} App
+  welcome: 欢迎使用${brand.name}
+brand:
+  name: 花子
+  url: https://example.com
+`, string(content))
+
+	// values filled by fallback are expanded in context of their own language
+	content, err = i18n.Export("zh-CN", i18n.ExportJSON, i18n.ExportOpts{Fallback: true, ExpandTemplates: true})
+	as.Nil(err)
+	as.Eq(`{
+  "app": {
+    "enOnly": "English only <Hanako>",
+    "refer": "Hanako",
+    "support": "Contact: https://example.com",
+    "title": "花子 App",
+    "welcome": "欢迎使用花子"
+  },
+  "brand": {
+    "name": "花子",
+    "url": "https://example.com"
+  }
+}
+`, string(content))
+
+	_, err = i18n.Export("zh-CN", "toml")
+	as.NotNil(err)
+	_, err = i18n.Export("ja", i18n.ExportYAML)
+	as.NotNil(err)
+}
@@ -0,0 +1,51 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n/i18nextract"
+	"path/filepath"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	as := assert.New(t)
+
+	usages, err := i18nextract.Extract(i18nextract.Opts{}, "./testdata/extract")
+	as.Nil(err)
+	as.Eq([]i18nextract.Usage{
+		{Func: "GetString", Path: `main."cycleA"`, File: filepath.Join("testdata", "extract", "alias.go"), Line: 8, Column: 21},
+		{Func: "GetString", Path: "main.hello", File: filepath.Join("testdata", "extract", "main.go"), Line: 10, Column: 29},
+		{Func: "GetStringTrF", Path: "main.count", File: filepath.Join("testdata", "extract", "main.go"), Line: 11, Column: 38},
+		{Func: "Get", Path: "main.typo", File: filepath.Join("testdata", "extract", "main.go"), Line: 12, Column: 31},
+	}, usages)
+
+	// include tests
+	usages, err = i18nextract.Extract(i18nextract.Opts{Tests: true}, "./testdata/extract")
+	as.Nil(err)
+	as.Eq(5, len(usages))
+
+	// testdata is skipped in recursive mode
+	usages, err = i18nextract.Extract(i18nextract.Opts{}, "./lang/...")
+	as.Nil(err)
+	as.Eq(0, len(usages))
+}
+
+func TestExtractCheck(t *testing.T) {
+	as := assert.New(t)
+
+	usages, err := i18nextract.Extract(i18nextract.Opts{}, "./testdata/extract")
+	as.Nil(err)
+
+	report, err := i18nextract.Check(usages, "./lint", "en")
+	as.Nil(err)
+
+	as.Eq(1, len(report.Undefined))
+	as.Eq("main.typo", report.Undefined[0].Path)
+
+	// main.cycleB is referred by main.cycleA
+	as.Eq(2, len(report.Unused))
+	as.Eq("main.broken", report.Unused[0].Path)
+	as.Eq(filepath.Join("lint", "en", "main.yaml"), report.Unused[0].File)
+	as.Eq(6, report.Unused[0].Line)
+	as.Eq("main.greeting", report.Unused[1].Path)
+}
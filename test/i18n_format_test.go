@@ -1,8 +1,10 @@
 package test
 
 import (
+	"errors"
 	"github.com/gookit/goutil/testutil/assert"
 	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nfs"
 	"testing"
 )
 
@@ -65,3 +67,51 @@ func TestTemplate(t *testing.T) {
 		i18n.GetStringTr("zh-CN", "test.temp_test3"),
 	)
 }
+
+func TestCheckFormats(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	report, err := i18n.CheckFormats()
+	as.Nil(err)
+	as.Eq(i18n.FormatMismatch{
+		Path:       "diff.placeholder",
+		Lang:       "en",
+		Format:     "count: %s name: %s",
+		BaseLang:   "zh-CN",
+		BaseFormat: "数量:%d 名称:%s",
+		Reason:     "argument 1 is formatted by %s, but by %d in base",
+	}, report["diff.placeholder"]["en"])
+	as.Contains(report, "diff.placeholder")
+	// precision is allowed to be different
+	as.NotContains(report, "diff.precision")
+	// arguments are reordered or skipped by explicit indexes
+	as.NotContains(report, "diff.reorder")
+	as.NotContains(report, "diff.skip")
+	// named placeholders must have the same names and types
+	as.Eq("placeholder {total} isn't in base", report["diff.renamed"]["en"].Reason)
+	as.Eq("placeholder {when} is formatted as number, but as date in base", report["diff.retyped"]["en"].Reason)
+	as.NotContains(report, "diff.named")
+
+	// check at load time
+	i18n.Reset()
+	err = i18n.Init(i18n.Opts{
+		FSOpts: i18n.FSOpts{
+			FSMode:  i18nfs.ModeEmbed,
+			Prefix:  "lang",
+			EmbedFS: &testdata,
+		},
+		DefaultLang:  "zh-CN",
+		FallbackLang: "en",
+		Languages:    []string{"en", "zh-CN"},
+		CheckFormats: true,
+	})
+	var formatCheckError *i18n.FormatCheckError
+	as.True(errors.As(err, &formatCheckError))
+	as.Eq("diff.placeholder", formatCheckError.Report.Mismatches()[0].Path)
+	as.Contains(err.Error(), "path [diff.placeholder] of language [en]")
+	// package isn't left half initialized, so it can be initialized again
+	as.False(i18n.Initialized())
+	TestLoadEmbed(t)
+}
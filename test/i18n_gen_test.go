@@ -0,0 +1,78 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18ngen"
+	"github.com/hanakogo/i18n/test/msgs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+//go:generate go run ../cmd/i18n gen -dir testdata/gen -lang en -pkg msgs -o msgs/msgs.go
+
+func TestGenerate(t *testing.T) {
+	as := assert.New(t)
+
+	source, err := i18ngen.Generate(i18ngen.Opts{Dir: "./testdata/gen", Lang: "en", Package: "msgs"})
+	as.Nil(err)
+
+	// generated package is up to date
+	generated, err := os.ReadFile("./msgs/msgs.go")
+	as.Nil(err)
+	as.Eq(string(generated), string(source))
+
+	_, err = i18ngen.Generate(i18ngen.Opts{Dir: "./testdata/gen", Lang: "ja", Package: "msgs"})
+	as.NotNil(err)
+
+	// parameters of placeholders don't collide with lang and keywords, untyped placeholder is typed by others
+	dir := t.TempDir()
+	as.Nil(os.MkdirAll(filepath.Join(dir, "en"), 0o755))
+	content := `message: "{lang} {type} {user_name} {n} {n, number} {at, time} {at, number}"`
+	as.Nil(os.WriteFile(filepath.Join(dir, "en", "main.yaml"), []byte(content), 0o644))
+	source, err = i18ngen.Generate(i18ngen.Opts{Dir: dir, Lang: "en", Package: "msgs"})
+	as.Nil(err)
+	as.StrContains(string(source), "func Message(lang string, lang2 any, typeArg any, userName any, n float64, at any) string")
+	as.StrContains(string(source), `i18n.Args{"lang": lang2, "type": typeArg, "user_name": userName, "n": n, "at": at}`)
+	as.NotContains(string(source), `"time"`)
+
+	// line breaks of values don't end doc comments
+	content = "multiline: \"first\\nsecond\"\nlines:\n  - \"a\\nb\"\n  - \"c\\r\\nd\"\n"
+	as.Nil(os.WriteFile(filepath.Join(dir, "en", "main.yaml"), []byte(content), 0o644))
+	source, err = i18ngen.Generate(i18ngen.Opts{Dir: dir, Lang: "en", Package: "msgs"})
+	as.Nil(err)
+	as.StrContains(string(source), `// Lines lines: [a b c d]`)
+	as.StrContains(string(source), `// Multiline multiline: "first second"`)
+
+	as.Eq("StrList", i18ngen.Identifier("str_list"))
+	as.Eq("HelloWorld", i18ngen.Identifier("hello world"))
+	as.Eq("X404", i18ngen.Identifier("404"))
+}
+
+func TestGeneratedAccessors(t *testing.T) {
+	as := assert.New(t)
+
+	err := initTestdata(t, "./testdata/gen", i18n.Opts{DefaultLang: "zh-CN", Languages: []string{"en", "zh-CN"}})
+	as.Nil(err)
+
+	as.Eq("我的应用", msgs.App.Title("zh-CN"))
+	as.Eq("My App", msgs.App.Title("en"))
+	as.Eq("深层的值", msgs.App.Nested.Deep("zh-CN"))
+	as.Eq("Alice 你好！你有 3 条新消息", msgs.App.Greeting("zh-CN", "Alice", 3))
+	as.Eq("Price: 1.50", msgs.App.Price("en", 1.5))
+	as.Eq("Bob has 2 items", msgs.App.Reorder("en", 2, "Bob"))
+	// named placeholders
+	since := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	as.Eq("Welcome back Alice, you have 1,200 new messages since Mar 5, 2024", msgs.App.Welcome("en", "Alice", 1200, since))
+	as.Eq("欢迎回来 Alice，自 2024年3月5日 起你有 1,200 条新消息", msgs.App.Welcome("zh-CN", "Alice", 1200, since))
+	as.Eq("My App is ready", msgs.App.Refer("en"))
+	as.Eq(int64(100), msgs.App.MaxUsers("en"))
+	as.Eq(0.75, msgs.App.Ratio("en"))
+	as.Eq(true, msgs.App.Enabled("en"))
+	as.Eq([]string{"a", "b"}, msgs.App.Tags("en"))
+	as.Eq([]float64{1, 2.5}, msgs.App.Limits("en"))
+	as.Eq("not found", msgs.X404("en"))
+	as.Eq("quoted", msgs.HelloWorld("en"))
+}
@@ -224,3 +224,70 @@ func TestGetSlice(t *testing.T) {
 		i18n.GetSliceTr[string]("en", "test.strList", i18n.ConvertString),
 	)
 }
+
+func TestPathIndex(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	// negative index
+	as.Eq("def", i18n.GetString("test.strList[-1]"))
+	as.Eq("c", i18n.GetStringTr("en", "test.strList[-1]"))
+	as.Eq("abc", i18n.GetString("test.strList[-2]"))
+
+	// multi-dimensional index
+	as.Eq(int64(2), i18n.GetInt64("test.grid[0][1]"))
+	as.Eq(int64(3), i18n.GetInt64("test.grid[-1][0]"))
+	as.Eq([]int64{3, 4}, i18n.GetSlice[int64]("test.grid[1]", i18n.ConvertInt64))
+
+	// slice of maps
+	as.Eq("item3", i18n.GetString("test.items[2].title"))
+	as.Eq("item3", i18n.GetString("test.items[-1].title"))
+
+	// invalid paths return default value instead of panicking
+	as.Eq("def", i18n.GetStringTr("zh-CN", "test.strList[2]", "def"))
+	as.Eq("def", i18n.GetStringTr("zh-CN", "test.strList[-3]", "def"))
+	as.Eq("def", i18n.GetString("test.grid[0][1][0]", "def"))
+	as.Eq("def", i18n.GetString("test.str1[0]", "def"))
+	as.Eq("def", i18n.GetString("test.strList[a]", "def"))
+	as.Eq("def", i18n.GetString("test.strList[0", "def"))
+	as.Eq("def", i18n.GetString("", "def"))
+	as.Eq([]string{}, i18n.GetSlice[string]("test.nullVal", i18n.ConvertString))
+	as.Eq([]string{}, i18n.GetSlice[string]("test.strList[5]", i18n.ConvertString))
+
+	ok, _ := i18n.HasPath("test.strList[9]")
+	as.Eq(false, ok)
+
+	_, err := i18n.GetValueTr("zh-CN", "test.strList[9]", nil)
+	as.Nil(err)
+}
+
+func TestQuotedPath(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	// keys containing dots aren't split into nested objects
+	as.Eq("版本1.2", i18n.GetString(`quoted."v1.2".title`))
+	as.Eq("版本1.2", i18n.GetString(`quoted["v1.2"].title`))
+	as.Eq("邮箱", i18n.GetString(`quoted."user@example.com"`))
+	as.Eq("冒号", i18n.GetString(`quoted."a:b"`))
+	as.Eq("def", i18n.GetString("quoted.v1.2.title", "def"))
+
+	// keys containing spaces
+	as.Eq("你好，世界", i18n.GetString(`quoted["Hello world"]`))
+	as.Eq("Hello world", i18n.GetStringTr("en", `quoted."Hello world"`))
+	as.Eq("def", i18n.GetString("quoted.Hello world", "def"))
+
+	// invalid quoted path
+	as.Eq("def", i18n.GetString(`quoted."v1.2.title`, "def"))
+	as.Eq("def", i18n.GetString(`quoted."v1.2"title`, "def"))
+
+	ok, contains := i18n.HasPath(`quoted["Hello world"]`)
+	as.Eq(true, ok)
+	as.Eq(2, len(contains))
+
+	// template references
+	as.Eq("引用:版本1.2", i18n.GetString("quoted.template1"))
+	as.Eq("引用:Hello world", i18n.GetString("quoted.template2"))
+}
@@ -11,6 +11,26 @@ import (
 //go:embed lang
 var testdata embed.FS
 
+// initTestdata init i18n with catalog in dir, it's read from filesystem unless opts has EmbedFS, default and fallback
+// languages are "en" unless opts has them. catalog of other tests is restored after the test
+func initTestdata(t *testing.T, dir string, opts i18n.Opts) error {
+	t.Helper()
+	t.Cleanup(func() { TestLoadEmbed(t) })
+
+	opts.FSOpts.Prefix = dir
+	if opts.FSOpts.EmbedFS == nil {
+		opts.FSOpts.FSMode = i18nfs.ModeFileSystem
+	}
+	if opts.DefaultLang == "" {
+		opts.DefaultLang = "en"
+	}
+	if opts.FallbackLang == "" {
+		opts.FallbackLang = "en"
+	}
+	i18n.Reset()
+	return i18n.Init(opts)
+}
+
 func TestLoadEmbed(t *testing.T) {
 	as := assert.New(t)
 
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(
+		[]string{"tips.first.body", "tips.first.title", "tips.second.body", "tips.second.title"},
+		i18n.Keys("zh-CN", "tips"),
+	)
+	as.Eq(
+		[]string{
+			"tips.first.body", "tips.first.title",
+			"tips.second.body", "tips.second.title",
+			"tips.third.body", "tips.third.title",
+		},
+		i18n.KeysWithFallback("zh-CN", "tips"),
+	)
+
+	// prefix points to a value
+	as.Eq([]string{"test.str1"}, i18n.Keys("zh-CN", "test.str1"))
+	// prefix with index
+	as.Eq([]string{"test.items[0].title"}, i18n.Keys("zh-CN", "test.items[0]"))
+	// keys are quoted if necessary
+	as.Eq([]string{`quoted."v1.2".title`}, i18n.Keys("zh-CN", `quoted["v1.2"]`))
+	as.Contains(i18n.Keys("zh-CN", "quoted"), `quoted."v1.2".title`)
+	as.Contains(i18n.Keys("zh-CN", ""), "fruits.banana")
+
+	as.Eq(0, len(i18n.Keys("zh-CN", "tips.not.exist")))
+	as.Eq(0, len(i18n.Keys("not-exist", "")))
+}
+
+func TestFind(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(
+		[]string{"tips.first.title", "tips.second.title"},
+		i18n.Find("zh-CN", "tips.*.title"),
+	)
+	as.Eq(
+		[]string{"tips.first", "tips.second"},
+		i18n.Find("zh-CN", "tips.*"),
+	)
+	as.Eq(
+		[]string{"tips.first", "tips.second", "tips.third"},
+		i18n.FindWithFallback("zh-CN", "tips.*"),
+	)
+	as.Eq(
+		[]string{"tips.first.body", "tips.first.title"},
+		i18n.Find("en", "tips.f*.*"),
+	)
+	as.Eq(
+		[]string{`quoted."v1.2".title`, "tips.first.title", "tips.second.title"},
+		i18n.Find("zh-CN", "**.title"),
+	)
+
+	// index isn't supported in pattern
+	as.Eq(0, len(i18n.Find("zh-CN", "test.items[0].*")))
+}
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"sync"
+	"testing"
+)
+
+func TestSetDefaultLang(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	var changes []i18n.LangChange
+	unsubscribe := i18n.OnLangChange(func(change i18n.LangChange) {
+		changes = append(changes, change)
+	})
+
+	// language must be loaded
+	as.NotNil(i18n.SetDefaultLang("ja"))
+	as.NotNil(i18n.SetDefaultLang("common"))
+	as.Eq("zh-CN", i18n.GetDefaultLang())
+	as.Empty(changes)
+
+	as.Nil(i18n.SetDefaultLang("en"))
+	as.Eq("en", i18n.GetDefaultLang())
+	as.Eq("test1", i18n.GetString("test.str1"))
+	// deprecated variables mirror the languages
+	as.Eq("en", i18n.DefaultLang)
+	as.Eq("en", i18n.FallbackLang)
+	as.Eq([]i18n.LangChange{{
+		OldDefaultLang:   "zh-CN",
+		DefaultLang:      "en",
+		OldFallbackChain: []string{"en"},
+		FallbackChain:    []string{"en"},
+	}}, changes)
+
+	// nothing is changed
+	as.Nil(i18n.SetDefaultLang("en"))
+	as.Len(changes, 1)
+
+	unsubscribe()
+	as.Nil(i18n.SetDefaultLang("zh-CN"))
+	as.Len(changes, 1)
+
+	// subscribers are removed by Reset
+	i18n.OnLangChange(func(change i18n.LangChange) {
+		t.Error("subscriber isn't removed")
+	})
+	TestLoadEmbed(t)
+	as.Nil(i18n.SetDefaultLang("en"))
+}
+
+func TestSetFallbackChain(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Nil(i18n.Load("common"))
+	as.NotNil(i18n.SetFallbackChain())
+	as.NotNil(i18n.SetFallbackChain("common", "ja"))
+	as.Eq([]string{"en"}, i18n.GetFallbackChain())
+
+	as.Nil(i18n.SetDefaultLang("common"))
+	as.Nil(i18n.SetFallbackChain("zh-CN", "en"))
+	as.Eq("zh-CN", i18n.GetFallbackLang())
+	as.Eq([]string{"zh-CN", "en"}, i18n.GetFallbackChain())
+	// languages are tried in order
+	as.Eq("common_str", i18n.GetString("common.str1"))
+	as.Eq("测试", i18n.GetString("test.str1"))
+	as.Eq("eng", i18n.GetString("test.engOnlyStr"))
+	as.True(i18n.GetBool("test.engOnlyBool"))
+	as.Eq(int64(654), i18n.GetInt64Tr("en", "test.num1"))
+	as.Eq(int64(123), i18n.GetInt64("test.num1"))
+	as.Contains(i18n.KeysWithFallback("common", "test"), "test.engOnlyStr")
+
+	// language of chain can only be unloaded with a replacement
+	as.NotNil(i18n.Unload("en"))
+	as.Nil(i18n.Unload("en", i18n.UnloadOpts{FallbackLang: "zh-CN"}))
+	// duplicates are removed
+	as.Eq([]string{"zh-CN"}, i18n.GetFallbackChain())
+	as.Eq("default", i18n.GetString("test.engOnlyStr", "default"))
+
+	as.Nil(i18n.SetFallbackLang("common"))
+	as.Eq([]string{"common"}, i18n.GetFallbackChain())
+	as.Eq("default", i18n.GetString("test.str1", "default"))
+}
+
+func TestSetDefaultLangConcurrently(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	results := make(chan string, 400)
+	var wg sync.WaitGroup
+	for idx := 0; idx < 4; idx++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 50; n++ {
+				lang := "en"
+				if n%2 == 0 {
+					lang = "zh-CN"
+				}
+				_ = i18n.SetDefaultLang(lang)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 50; n++ {
+				results <- i18n.GetString("test.str1")
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	for result := range results {
+		as.Contains([]string{"test1", "测试"}, result)
+	}
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+// layersOpts options of catalog in ./testdata/layers
+var layersOpts = i18n.Opts{
+	DefaultLang: "zh-CN",
+	Languages:   []string{"en", "zh-CN"},
+	CommonLang:  "common",
+}
+
+func TestCommonLayer(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/layers", layersOpts))
+
+	// inherited from common layer
+	as.Eq("https://example.com", i18n.GetStringTr("zh-CN", "brand.url"))
+	as.Eq("https://example.com", i18n.GetStringTr("en", "brand.url"))
+	// templates of common layer are parsed in context of language
+	as.Eq("花子 App", i18n.GetStringTr("zh-CN", "app.title"))
+	as.Eq("Contact: https://example.com", i18n.GetString("app.support"))
+	// language overrides common layer
+	as.Eq("Hanako App (EN)", i18n.GetStringTr("en", "app.title"))
+	as.Eq("Welcome to Hanako", i18n.GetStringTr("en", "app.welcome"))
+	as.Eq("欢迎使用花子", i18n.GetStringTr("zh-CN", "app.welcome"))
+	// common layer can be referred directly
+	as.Eq("Hanako", i18n.GetStringTr("zh-CN", "app.refer"))
+
+	layer, ok := i18n.Origin("zh-CN", "brand.url")
+	as.True(ok)
+	as.Eq("common", layer)
+	layer, ok = i18n.Origin("zh-CN", "brand.name")
+	as.True(ok)
+	as.Eq("zh-CN", layer)
+	_, ok = i18n.Origin("zh-CN", "brand.notExists")
+	as.False(ok)
+
+	ok, contains := i18n.HasPath("brand.url")
+	as.True(ok)
+	as.Eq(2, len(contains))
+	// common layer isn't a language
+	as.False(i18n.Has("common"))
+
+	as.Eq([]string{"brand.name", "brand.url"}, i18n.Keys("zh-CN", "brand"))
+	as.Eq([]string{"app.support", "app.title", "app.welcome", "brand.name", "brand.url"}, i18n.Keys("en", ""))
+}
+
+func TestCommonLayerDiff(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/layers", layersOpts))
+
+	report, err := i18n.Diff("en", "zh-CN")
+	as.Nil(err)
+	as.Eq([]string{"app.title"}, report.Inherited)
+	as.Eq(0, len(report.Missing))
+	as.Eq([]string{"app.refer"}, report.Extra)
+
+	report, err = i18n.Diff("zh-CN", "en")
+	as.Nil(err)
+	as.Eq([]string{"brand.name"}, report.Inherited)
+}
@@ -0,0 +1,121 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nfs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// lazyOpts options of embedded catalog in lazy mode
+func lazyOpts(idleTimeout time.Duration) i18n.Opts {
+	return i18n.Opts{
+		FSOpts:      i18n.FSOpts{FSMode: i18nfs.ModeEmbed, EmbedFS: &testdata},
+		DefaultLang: "zh-CN",
+		Lazy:        true,
+		IdleTimeout: idleTimeout,
+	}
+}
+
+func TestLazyLoading(t *testing.T) {
+	as := assert.New(t)
+
+	as.Nil(initTestdata(t, "lang", lazyOpts(0)))
+	// default and fallback languages are read by Init
+	as.True(i18n.Has("zh-CN"))
+	as.True(i18n.Has("en"))
+	as.False(i18n.Has("common"))
+
+	// concurrent first lookups
+	var wg sync.WaitGroup
+	values := make(chan string, 8)
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values <- i18n.GetStringTr("common", "common.str1")
+		}()
+	}
+	wg.Wait()
+	close(values)
+	for value := range values {
+		as.Eq("common_str", value)
+	}
+	as.True(i18n.Has("common"))
+
+	// language doesn't exist
+	as.Eq("default", i18n.GetStringTr("ja", "test.str1", "default"))
+	as.False(i18n.Has("ja"))
+
+	// nothing is evicted without IdleTimeout
+	as.Nil(i18n.EvictIdle())
+}
+
+func TestLazyAddMessages(t *testing.T) {
+	as := assert.New(t)
+
+	as.Nil(initTestdata(t, "lang", lazyOpts(0)))
+	as.False(i18n.Has("common"))
+	// values on disk are read before runtime messages are added
+	as.Nil(i18n.SetMessage("common", "common.runtime", "runtime"))
+	as.Eq("runtime", i18n.GetStringTr("common", "common.runtime"))
+	as.Eq("common_str", i18n.GetStringTr("common", "common.str1"))
+
+	// language which doesn't exist on disk
+	as.Nil(i18n.SetMessage("ja", "test.str1", "ja_runtime"))
+	as.Eq("ja_runtime", i18n.GetStringTr("ja", "test.str1"))
+}
+
+func TestIdleEviction(t *testing.T) {
+	as := assert.New(t)
+
+	as.Nil(initTestdata(t, "lang", lazyOpts(time.Hour)))
+	as.Eq("common_str", i18n.GetStringTr("common", "common.str1"))
+	// used just now
+	as.Nil(i18n.EvictIdle())
+
+	as.Nil(initTestdata(t, "lang", lazyOpts(time.Millisecond)))
+	as.Eq("common_str", i18n.GetStringTr("common", "common.str1"))
+	as.Nil(i18n.SetMessage("fr", "test.str1", "runtime"))
+	time.Sleep(10 * time.Millisecond)
+
+	// default and fallback languages and languages which only have runtime messages are kept,
+	// "common" may have been evicted by periodic eviction already
+	evicted := i18n.EvictIdle()
+	as.NotContains(evicted, "zh-CN")
+	as.NotContains(evicted, "en")
+	as.NotContains(evicted, "fr")
+	as.False(i18n.Has("common"))
+	as.True(i18n.Has("zh-CN"))
+	as.Eq("runtime", i18n.GetStringTr("fr", "test.str1"))
+
+	// read again on next lookup
+	as.Eq("common_str", i18n.GetStringTr("common", "common.str1"))
+}
+
+func TestLazyMissingLanguage(t *testing.T) {
+	as := assert.New(t)
+
+	dir := t.TempDir()
+	writeFile := func(lang string, content string) {
+		as.Nil(os.MkdirAll(filepath.Join(dir, lang), 0o755))
+		as.Nil(os.WriteFile(filepath.Join(dir, lang, "main.yaml"), []byte(content), 0o644))
+	}
+	writeFile("en", "title: Title")
+
+	as.Nil(initTestdata(t, dir, i18n.Opts{Lazy: true}))
+	as.Eq("default", i18n.GetStringTr("fr", "title", "default"))
+
+	// missing language is remembered, lookups don't check filesystem again
+	writeFile("fr", "title: Titre")
+	as.Eq("default", i18n.GetStringTr("fr", "title", "default"))
+	as.False(i18n.Has("fr"))
+
+	// explicit Load reads it
+	as.Nil(i18n.Load("fr"))
+	as.Eq("Titre", i18n.GetStringTr("fr", "title"))
+}
@@ -0,0 +1,123 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nlint"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func findDiagnostic(diagnostics []i18nlint.Diagnostic, rule i18nlint.Rule, lang string, path string) *i18nlint.Diagnostic {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Rule == rule && diagnostic.Lang == lang && diagnostic.Path == path {
+			return &diagnostic
+		}
+	}
+	return nil
+}
+
+func TestLint(t *testing.T) {
+	as := assert.New(t)
+
+	diagnostics, err := i18nlint.Lint(i18nlint.Opts{
+		Dir:         "./lint",
+		DefaultLang: "en",
+	})
+	as.Nil(err)
+
+	// invalid yaml
+	diagnostic := findDiagnostic(diagnostics, i18nlint.RuleYAML, "zh-CN", "")
+	as.NotNil(diagnostic)
+	as.Eq(filepath.Join("lint", "zh-CN", "invalid.yaml"), diagnostic.File)
+	as.Gt(diagnostic.Line, 0)
+
+	// override across files
+	diagnostic = findDiagnostic(diagnostics, i18nlint.RuleOverride, "en", "main.hello")
+	as.NotNil(diagnostic)
+	as.Eq(filepath.Join("lint", "en", "override.yaml"), diagnostic.File)
+	as.Eq(2, diagnostic.Line)
+	as.StrContains(diagnostic.Message, filepath.Join("lint", "en", "main.yaml")+":2:3")
+
+	// broken reference
+	as.NotNil(findDiagnostic(diagnostics, i18nlint.RuleReference, "en", "main.broken"))
+	as.Nil(findDiagnostic(diagnostics, i18nlint.RuleReference, "zh-CN", "main.broken"))
+
+	// reference cycle
+	as.NotNil(findDiagnostic(diagnostics, i18nlint.RuleCycle, "en", "main.cycleA"))
+	as.NotNil(findDiagnostic(diagnostics, i18nlint.RuleCycle, "zh-CN", "main.cycleA"))
+
+	// printf verbs
+	diagnostic = findDiagnostic(diagnostics, i18nlint.RuleFormat, "zh-CN", "main.count")
+	as.NotNil(diagnostic)
+	as.Eq(filepath.Join("lint", "zh-CN", "main.yaml")+":3:3: [format] "+diagnostic.Message, diagnostic.String())
+	// named placeholders
+	diagnostic = findDiagnostic(diagnostics, i18nlint.RuleFormat, "zh-CN", "main.greeting")
+	as.NotNil(diagnostic)
+	as.StrContains(diagnostic.Message, "placeholder {user} isn't in base")
+
+	// all keys of zh-CN are translated
+	for _, diagnostic := range diagnostics {
+		as.NotEq(i18nlint.RuleMissing, diagnostic.Rule)
+	}
+
+	_, err = i18nlint.Lint(i18nlint.Opts{Dir: "./lint", DefaultLang: "fr"})
+	as.NotNil(err)
+}
+
+func TestLintMissing(t *testing.T) {
+	as := assert.New(t)
+
+	diagnostics, err := i18nlint.Lint(i18nlint.Opts{
+		Dir:         "./lang",
+		DefaultLang: "zh-CN",
+		Languages:   []string{"zh-CN", "en"},
+	})
+	as.Nil(err)
+
+	diagnostic := findDiagnostic(diagnostics, i18nlint.RuleMissing, "zh-CN", "test.str2")
+	as.NotNil(diagnostic)
+	as.StrContains(diagnostic.Message, "[en]")
+}
+
+func TestLintCommonLayer(t *testing.T) {
+	as := assert.New(t)
+
+	// the common layer is linted as a language without CommonLang
+	diagnostics, err := i18nlint.Lint(i18nlint.Opts{Dir: "./testdata/layers", DefaultLang: "en"})
+	as.Nil(err)
+	as.NotNil(findDiagnostic(diagnostics, i18nlint.RuleMissing, "en", "app.welcome"))
+	as.NotNil(findDiagnostic(diagnostics, i18nlint.RuleMissing, "en", "app.title"))
+
+	// values of the common layer are inherited, templates are resolved in each language like what runtime does
+	diagnostics, err = i18nlint.Lint(i18nlint.Opts{Dir: "./testdata/layers", DefaultLang: "en", CommonLang: "common"})
+	as.Nil(err)
+	as.Eq(0, len(diagnostics))
+
+	// path of default language which only the common layer defines isn't missing
+	dir := t.TempDir()
+	as.Nil(os.MkdirAll(filepath.Join(dir, "common"), 0o755))
+	as.Nil(os.MkdirAll(filepath.Join(dir, "en"), 0o755))
+	as.Nil(os.MkdirAll(filepath.Join(dir, "ja"), 0o755))
+	as.Nil(os.WriteFile(filepath.Join(dir, "common", "main.yaml"), []byte("main:\n  brand: Hanako\n  obj:\n    x: x\n"), 0o644))
+	as.Nil(os.WriteFile(filepath.Join(dir, "en", "main.yaml"), []byte("main:\n  title: ${main.brand}\n"), 0o644))
+	as.Nil(os.WriteFile(filepath.Join(dir, "ja", "main.yaml"), []byte("main:\n  title: タイトル\n  obj: hidden\n"), 0o644))
+	diagnostics, err = i18nlint.Lint(i18nlint.Opts{Dir: dir, DefaultLang: "en", CommonLang: "common"})
+	as.Nil(err)
+	// main.obj.x is hidden by value main.obj of ja
+	as.Eq(1, len(diagnostics))
+	diagnostic := findDiagnostic(diagnostics, i18nlint.RuleMissing, "common", "main.obj.x")
+	as.NotNil(diagnostic)
+	as.Eq(filepath.Join(dir, "common", "main.yaml"), diagnostic.File)
+	as.StrContains(diagnostic.Message, "[ja]")
+}
+
+func TestTemplateCycle(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	// reference cycle doesn't overflow stack
+	as.StrContains(i18n.GetStringTr("en", "test.cycleA"), "<NotFound>")
+}
@@ -0,0 +1,83 @@
+package test
+
+import (
+	"fmt"
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"sync"
+	"testing"
+)
+
+func TestAddMessages(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	err := i18n.AddMessages("en", map[string]any{
+		"test.str1": "runtime",
+		"plugin": map[string]any{
+			"hello": "hello ${test.engOnlyStr}",
+		},
+	})
+	as.Nil(err)
+	// runtime messages are above values of files
+	as.Eq("runtime", i18n.GetStringTr("en", "test.str1"))
+	source, ok := i18n.Source("en", "test.str1")
+	as.True(ok)
+	as.Eq("runtime", source)
+	// other values of files are kept
+	as.Eq(int64(654), i18n.GetInt64Tr("en", "test.num1"))
+	// templates and fallback
+	as.Eq("hello eng", i18n.GetStringTr("en", "plugin.hello"))
+	as.Eq("hello eng", i18n.GetString("plugin.hello"))
+
+	// merged into existing runtime messages
+	as.Nil(i18n.SetMessage("en", "plugin.bye", "bye"))
+	as.Eq("bye", i18n.GetStringTr("en", "plugin.bye"))
+	as.Eq("hello eng", i18n.GetStringTr("en", "plugin.hello"))
+
+	// language without directory
+	as.False(i18n.Has("fr"))
+	as.Nil(i18n.SetMessage("fr", "plugin.hello", "bonjour"))
+	as.True(i18n.Has("fr"))
+	as.Eq("bonjour", i18n.GetStringTr("fr", "plugin.hello"))
+	ok, contains := i18n.HasPath("plugin.hello")
+	as.True(ok)
+	as.Contains(contains, "fr")
+
+	as.NotNil(i18n.SetMessage("en", "test.strList[0]", "x"))
+	as.NotNil(i18n.SetMessage("", "plugin.hello", "x"))
+}
+
+func TestAddMessagesConcurrently(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	values := make(chan string, 8)
+	for n := 0; n < 8; n++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			errs <- i18n.SetMessage("en", fmt.Sprintf("plugin.key%d", n), n)
+		}()
+		go func() {
+			defer wg.Done()
+			values <- i18n.GetString("test.str1")
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	close(values)
+	for err := range errs {
+		as.Nil(err)
+	}
+	for value := range values {
+		as.Eq("测试", value)
+	}
+	as.Eq(8, len(i18n.Keys("en", "plugin")))
+}
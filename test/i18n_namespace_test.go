@@ -0,0 +1,78 @@
+package test
+
+import (
+	"embed"
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nextract"
+	"github.com/hanakogo/i18n/i18nfs"
+	"github.com/hanakogo/i18n/i18ngen"
+	"github.com/hanakogo/i18n/i18nlint"
+	"testing"
+)
+
+//go:embed testdata/namespace
+var namespaceData embed.FS
+
+// namespaceOpts options of catalog in testdata/namespace, it's embedded too
+func namespaceOpts(fsMode i18nfs.FSMode, namespaced bool) i18n.Opts {
+	return i18n.Opts{
+		FSOpts:    i18n.FSOpts{FSMode: fsMode, EmbedFS: &namespaceData, Namespaced: namespaced},
+		Languages: []string{"en"},
+	}
+}
+
+func TestRecursiveLoading(t *testing.T) {
+	as := assert.New(t)
+
+	// both modes read files of subdirectories, main.yaml is merged after billing/invoice.yaml
+	for _, fsMode := range []i18nfs.FSMode{i18nfs.ModeEmbed, i18nfs.ModeFileSystem} {
+		as.Nil(initTestdata(t, "testdata/namespace", namespaceOpts(fsMode, false)))
+		as.Eq("Main", i18n.GetString("title"))
+		as.Eq("Total: 1.50", i18n.GetStringF("total", 1.5))
+		as.Eq(1, len(i18n.Overrides("en")))
+	}
+}
+
+func TestNamespacedLoading(t *testing.T) {
+	as := assert.New(t)
+
+	for _, fsMode := range []i18nfs.FSMode{i18nfs.ModeEmbed, i18nfs.ModeFileSystem} {
+		as.Nil(initTestdata(t, "testdata/namespace", namespaceOpts(fsMode, true)))
+		as.Eq("Main", i18n.GetString("main.title"))
+		as.Eq("Invoice", i18n.GetString("billing.invoice.title"))
+		as.Eq("Total: 1.50", i18n.GetStringF("billing.invoice.total", 1.5))
+		as.False(i18n.HasPath("title"))
+		as.Eq(0, len(i18n.Overrides("en")))
+	}
+
+	// lint understands namespaces too
+	diagnostics, err := i18nlint.Lint(i18nlint.Opts{Dir: "./testdata/namespace", DefaultLang: "en", Namespaced: true})
+	as.Nil(err)
+	as.Eq(0, len(diagnostics))
+	diagnostics, err = i18nlint.Lint(i18nlint.Opts{Dir: "./testdata/namespace", DefaultLang: "en"})
+	as.Nil(err)
+	as.Eq(i18nlint.RuleOverride, diagnostics[0].Rule)
+
+	// so do generator and extract report
+	source, err := i18ngen.Generate(i18ngen.Opts{Dir: "./testdata/namespace", Lang: "en", Package: "msgs", Namespaced: true})
+	as.Nil(err)
+	as.StrContains(string(source), `i18n.GetStringTr(lang, "billing.invoice.title")`)
+	as.StrContains(string(source), `i18n.GetStringTr(lang, "main.welcome")`)
+	source, err = i18ngen.Generate(i18ngen.Opts{Dir: "./testdata/namespace", Lang: "en", Package: "msgs"})
+	as.Nil(err)
+	as.NotContains(string(source), "billing.invoice")
+
+	usages := []i18nextract.Usage{{Func: "GetString", Path: "billing.invoice.title"}}
+	report, err := i18nextract.Check(usages, "./testdata/namespace", "en", i18nextract.CheckOpts{Namespaced: true})
+	as.Nil(err)
+	as.Eq(0, len(report.Undefined))
+	var unused []string
+	for _, key := range report.Unused {
+		unused = append(unused, key.Path)
+	}
+	as.Eq([]string{"billing.invoice.total", "main.title", "main.welcome"}, unused)
+	report, err = i18nextract.Check(usages, "./testdata/namespace", "en")
+	as.Nil(err)
+	as.Eq(1, len(report.Undefined))
+}
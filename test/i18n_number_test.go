@@ -0,0 +1,75 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+// formatOpts options of catalog in ./testdata/format
+var formatOpts = i18n.Opts{
+	DefaultLang: "de",
+	Languages:   []string{"ar", "de", "en"},
+}
+
+func TestFormatNumber(t *testing.T) {
+	as := assert.New(t)
+
+	as.Eq("1,234,567.5", i18n.FormatNumber("en", 1234567.5))
+	as.Eq("1.234.567,5", i18n.FormatNumber("de", 1234567.5))
+	as.Eq("1\u00a0234\u00a0567,5", i18n.FormatNumber("fr", 1234567.5))
+	as.Eq("12,34,567.5", i18n.FormatNumber("hi", 1234567.5))
+	as.Eq("-1.234", i18n.FormatNumber("de", -1234))
+	as.Eq("not a number", i18n.FormatNumber("de", "not a number"))
+
+	// fraction digits
+	as.Eq("1.50", i18n.FormatNumber("en", 1.5, i18n.NumberOpts{MinFractionDigits: 2}))
+	as.Eq("3.14", i18n.FormatNumber("en", 3.14159, i18n.NumberOpts{MaxFractionDigits: 2}))
+	as.Eq("1,235", i18n.FormatNumber("en", 1234.5678, i18n.NumberOpts{MaxFractionDigits: i18n.NoFractionDigits}))
+
+	// styles
+	as.Eq("26%", i18n.FormatNumber("en", 0.256, i18n.NumberOpts{Style: i18n.NumberPercent}))
+	as.Eq("25,6\u00a0%", i18n.FormatNumber("de", 0.256, i18n.NumberOpts{Style: i18n.NumberPercent, MaxFractionDigits: 1}))
+	as.Eq("1.2K", i18n.FormatNumber("en", 1234, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("3,5M", i18n.FormatNumber("de", 3_456_789, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("-2B", i18n.FormatNumber("en", -2e9, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("999", i18n.FormatNumber("en", 999, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	// rounded before unit is chosen
+	as.Eq("1M", i18n.FormatNumber("en", 999999, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("1K", i18n.FormatNumber("en", 999.96, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("-1B", i18n.FormatNumber("en", -999_950_000, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("999.9K", i18n.FormatNumber("en", 999_940, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("1,000T", i18n.FormatNumber("en", 999_999e9, i18n.NumberOpts{Style: i18n.NumberCompact}))
+	as.Eq("1.23\u202f×\u202f10⁶", i18n.FormatNumber("en", 1234567.5, i18n.NumberOpts{
+		Style:             i18n.NumberScientific,
+		MaxFractionDigits: 2,
+	}))
+
+	// native digits
+	as.Eq("١٬٢٣٤٫٥", i18n.FormatNumber("ar", 1234.5))
+	as.Eq("١٬٢٣٤٫٥", i18n.FormatNumber("en", 1234.5, i18n.NumberOpts{Digits: "arab"}))
+	as.Eq("१,२३४.५", i18n.FormatNumber("en", 1234.5, i18n.NumberOpts{Digits: "deva"}))
+}
+
+func TestFormatMessage(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/format", formatOpts))
+
+	as.Eq("Gesamt: 1.234.567", i18n.GetMessage("stats.total", i18n.Args{"count": 1234567}))
+	as.Eq("Total: 1,234,567", i18n.GetMessageTr("en", "stats.total", i18n.Args{"count": 1234567}))
+	as.Eq("المجموع: ١٬٢٣٤", i18n.GetMessageTr("ar", "stats.total", i18n.Args{"count": 1234}))
+	as.Eq("1,2K Aufrufe", i18n.GetMessage("stats.views", i18n.Args{"views": 1234}))
+	as.Eq("Erfolgsquote: 98\u00a0%", i18n.GetMessage("stats.ratio", i18n.Args{"ratio": 0.98}))
+	as.Eq("Preis: 5,00", i18n.GetMessage("stats.price", i18n.Args{"price": 5}))
+	as.Eq("Price: 1,234.57", i18n.GetMessageTr("en", "stats.price", i18n.Args{"price": 1234.567}))
+
+	// fallback message is formatted for its own language
+	as.Eq("Hello, Alice! {missing} {name, unknown}", i18n.GetMessage("stats.name", i18n.Args{"name": "Alice"}))
+	as.Eq(i18n.DefaultString, i18n.GetMessage("stats.notExists", nil))
+
+	// templates are kept
+	as.Eq("${a} 1", i18n.FormatMessage("en", "${a} {b}", i18n.Args{"a": 0, "b": 1}))
+	// style is invalid
+	as.Eq("{b, number, bad}", i18n.FormatMessage("en", "{b, number, bad}", i18n.Args{"b": 1}))
+	as.Eq("{b, number}", i18n.FormatMessage("en", "{b, number}", i18n.Args{"b": "text"}))
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"github.com/hanakogo/i18n/i18nfs"
+	"testing"
+)
+
+func TestOverlays(t *testing.T) {
+	as := assert.New(t)
+
+	err := initTestdata(t, "lang", i18n.Opts{
+		FSOpts: i18n.FSOpts{FSMode: i18nfs.ModeEmbed, EmbedFS: &testdata},
+		Overlays: []i18n.FSOpts{
+			{Name: "custom", FSMode: i18nfs.ModeFileSystem, Prefix: "./testdata/overlay/first"},
+			{FSMode: i18nfs.ModeFileSystem, Prefix: "./testdata/overlay/second"},
+		},
+		DefaultLang: "zh-CN",
+		Languages:   []string{"en", "zh-CN", "ja"},
+	})
+	as.Nil(err)
+
+	// later overlay wins
+	as.Eq("second", i18n.GetStringTr("en", "test.str1"))
+	source, ok := i18n.Source("en", "test.str1")
+	as.True(ok)
+	as.Eq("overlay2", source)
+
+	as.Eq("first", i18n.GetStringTr("en", "test.engOnlyStr"))
+	source, _ = i18n.Source("en", "test.engOnlyStr")
+	as.Eq("custom", source)
+
+	// values which aren't overridden come from base
+	as.Eq(int64(654), i18n.GetInt64Tr("en", "test.num1"))
+	source, _ = i18n.Source("en", "test.num1")
+	as.Eq("base", source)
+	layer, _ := i18n.Origin("en", "test.num1")
+	as.Eq("en", layer)
+
+	// language only exists in overlay
+	as.True(i18n.Has("ja"))
+	as.Eq("ja", i18n.GetStringTr("ja", "test.refer"))
+	// other languages aren't affected, fallback sees overlays
+	as.Eq("测试", i18n.GetStringTr("zh-CN", "test.str1"))
+	as.Eq("first", i18n.GetString("test.engOnlyStr"))
+	_, ok = i18n.Source("ja", "test.num1")
+	as.False(ok)
+}
@@ -0,0 +1,33 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+func TestPrinter(t *testing.T) {
+	as := assert.New(t)
+
+	as.Eq("1.234.567", i18n.Printer("de").Sprintf("%d", 1234567))
+	as.Eq("1,234.5", i18n.Printer("en").Sprintf("%v", 1234.5))
+	as.Eq("١٬٢٣٤", i18n.Printer("ar").Sprintf("%d", 1234))
+	// printers are cached
+	as.True(i18n.Printer("de") == i18n.Printer("de"))
+}
+
+func TestLocalizedPrintf(t *testing.T) {
+	as := assert.New(t)
+	as.Nil(initTestdata(t, "./testdata/format", formatOpts))
+
+	// formatted by fmt without the option
+	as.Eq("1234567 Einträge (1234.5 MB)", i18n.GetStringF("stats.items", 1234567, 1234.5))
+
+	opts := formatOpts
+	opts.LocalizedPrintf = true
+	as.Nil(initTestdata(t, "./testdata/format", opts))
+	as.Eq("1.234.567 Einträge (1.234,5 MB)", i18n.GetStringF("stats.items", 1234567, 1234.5))
+	as.Eq("1,234,567 items (1,234.5 MB)", i18n.GetStringTrF("en", "stats.items", 1234567, 1234.5))
+	// fallback string is formatted for its own language
+	as.Eq("1,234 items", i18n.GetStringF("stats.enItems", 1234))
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"testing"
+)
+
+func TestPseudoLocale(t *testing.T) {
+	as := assert.New(t)
+
+	err := initTestdata(t, "./testdata/layers", i18n.Opts{
+		Languages:  []string{"en", "zh-CN"},
+		CommonLang: "common",
+		PseudoLocales: []i18n.PseudoLocale{
+			{Lang: "en-XA", Expansion: 0.3},
+			{Lang: "ar-XB", Mirror: true},
+		},
+	})
+	as.Nil(err)
+
+	// templates refer to values of pseudo-locale itself
+	as.Eq("[Ŵéļçöɱé ţö [Ĥåñåķö ~~] ~~~~]", i18n.GetStringTr("en-XA", "app.welcome"))
+	// values of the common layer are generated too
+	as.Eq("[Ĥåñåķö ~~]", i18n.GetStringTr("en-XA", "brand.name"))
+	as.Eq("[\u202eĤåñåķö Åþþ (ÉÑ)\u202c]", i18n.GetStringTr("ar-XB", "app.title"))
+	as.Eq(
+		"[\u202eŴéļçöɱé ţö \u202c[\u202eĤåñåķö\u202c]]",
+		i18n.GetStringTr("ar-XB", "app.welcome"),
+	)
+
+	as.NotNil(i18n.LoadPseudoLocale(i18n.PseudoLocale{Lang: "en"}))
+	as.NotNil(i18n.LoadPseudoLocale(i18n.PseudoLocale{Lang: "zh-CN", Source: "en"}))
+}
+
+func TestPseudoLocaleFormat(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Nil(i18n.LoadPseudoLocale(i18n.PseudoLocale{Lang: "en-XA", Source: "en"}))
+	// printf verbs are kept
+	as.Eq("[çöûñţ: %s ñåɱé: %s]", i18n.GetStringTr("en-XA", "diff.placeholder"))
+	as.Eq("[Alice ĥåš 3 îţéɱš]", i18n.GetStringTrF("en-XA", "diff.reorder", 3, "Alice"))
+	as.Eq([]string{"[å]", "[ƀ]", "[ç]"}, i18n.GetSliceTr("en-XA", "test.strList", i18n.ConvertString))
+	// placeholders are kept
+	as.Nil(i18n.SetMessage("en", "test.views", "{views, number} views"))
+	as.Nil(i18n.Reload("en-XA"))
+	as.Eq("[1,234 ṽîéŵš]", i18n.GetMessageTr("en-XA", "test.views", i18n.Args{"views": 1234}))
+
+	// generated again by Reload
+	as.Nil(i18n.SetMessage("en", "test.str1", "changed"))
+	as.Eq("[ţéšţ1]", i18n.GetStringTr("en-XA", "test.str1"))
+	as.Nil(i18n.Reload("en-XA"))
+	as.Eq("[çĥåñĝéð]", i18n.GetStringTr("en-XA", "test.str1"))
+
+	as.Nil(i18n.Unload("en-XA"))
+	as.False(i18n.Has("en-XA"))
+	as.NotNil(i18n.Reload("en-XA"))
+}
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestGetBool(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(true, i18n.GetBool("test.bool1"))
+	as.Eq(false, i18n.GetBool("test.bool2", true))
+	// fallback
+	as.Eq(true, i18n.GetBool("test.engOnlyBool"))
+	// can't convert, use default value
+	as.Eq(true, i18n.GetBool("test.str1", true))
+	as.Eq(false, i18n.GetBoolTr("zh-CN", "test.engOnlyBool"))
+
+	_, err := i18n.ConvertAnyToBoolE("abc")
+	as.NotNil(err)
+}
+
+func TestGetUint64(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(uint64(123), i18n.GetUint64("test.num1"))
+	as.Eq(uint64(18446744073709551615), i18n.GetUint64("test.uint1"))
+	as.Eq(uint64(654), i18n.GetUint64Tr("en", "test.num1"))
+	// negative value isn't wrapped around
+	as.Eq(uint64(7), i18n.GetUint64("test.negNum", 7))
+
+	_, err := i18n.ConvertAnyToUint64E(-1)
+	as.NotNil(err)
+}
+
+func TestGetDuration(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(90*time.Minute, i18n.GetDuration("test.duration1"))
+	// fallback
+	as.Eq(10*time.Second, i18n.GetDuration("test.engOnlyDuration"))
+	as.Eq(time.Second, i18n.GetDurationTr("zh-CN", "test.str1", time.Second))
+}
+
+func TestGetTime(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(
+		time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		i18n.GetTime("test.time1", ""),
+	)
+	as.Eq(
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		i18n.GetTimeTr("zh-CN", "test.time2", "2006/01/02"),
+	)
+	as.Eq(
+		i18n.DefaultTime,
+		i18n.GetTime("test.time2", time.RFC3339),
+	)
+}
+
+func TestGetBigFloat(t *testing.T) {
+	TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Eq(
+		"123456789012345678901234567890.123456789",
+		i18n.GetBigFloat("test.bigNum").Text('f', 9),
+	)
+	as.Eq(
+		"654.321",
+		i18n.GetBigFloatTr("en", "test.num2").Text('f', -1),
+	)
+	as.Nil(i18n.GetBigFloat("test.str1"))
+
+	def := big.NewFloat(1)
+	as.Eq(def, i18n.GetBigFloat("test.bigNum.not.exist", def))
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"github.com/gookit/goutil/testutil/assert"
+	"github.com/hanakogo/i18n"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnload(t *testing.T) {
+	TestLoadEmbed(t)
+	defer TestLoadEmbed(t)
+
+	as := assert.New(t)
+
+	as.Nil(i18n.Load("common"))
+	as.Nil(i18n.Unload("common"))
+	as.False(i18n.Has("common"))
+	as.NotNil(i18n.Unload("common"))
+
+	// default and fallback language can't be unloaded without replacement
+	as.NotNil(i18n.Unload("zh-CN"))
+	as.NotNil(i18n.Unload("en"))
+	// replacement must be loaded
+	as.NotNil(i18n.Unload("zh-CN", i18n.UnloadOpts{DefaultLang: "ja"}))
+	as.True(i18n.Has("zh-CN"))
+
+	as.Nil(i18n.Unload("zh-CN", i18n.UnloadOpts{DefaultLang: "en"}))
+	as.False(i18n.Has("zh-CN"))
+	as.Eq("en", i18n.GetDefaultLang())
+	as.Eq("test1", i18n.GetString("test.str1"))
+
+	i18n.Reset()
+	as.Eq("", i18n.GetDefaultLang())
+	as.Eq("", i18n.GetFallbackLang())
+}
+
+func TestReload(t *testing.T) {
+	as := assert.New(t)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "en", "main.yaml")
+	as.Nil(os.MkdirAll(filepath.Dir(file), 0o755))
+	as.Nil(os.WriteFile(file, []byte("main:\n  title: before\n"), 0o644))
+
+	as.Nil(initTestdata(t, dir, i18n.Opts{Languages: []string{"en"}}))
+	as.Nil(i18n.SetMessage("en", "main.runtime", "runtime"))
+	as.Eq("before", i18n.GetString("main.title"))
+
+	as.Nil(os.WriteFile(file, []byte("main:\n  title: after\n"), 0o644))
+	as.Nil(i18n.Reload("en"))
+	as.Eq("after", i18n.GetString("main.title"))
+	// runtime messages are kept
+	as.Eq("runtime", i18n.GetString("main.runtime"))
+
+	// values are kept if reloading fails
+	as.Nil(os.WriteFile(file, []byte("main: [\n"), 0o644))
+	as.NotNil(i18n.Reload("en"))
+	as.Eq("after", i18n.GetString("main.title"))
+
+	as.NotNil(i18n.Reload("ja"))
+}
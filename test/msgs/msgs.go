@@ -0,0 +1,87 @@
+// Code generated by i18n gen; DO NOT EDIT.
+
+package msgs
+
+import (
+	"github.com/hanakogo/i18n"
+	"time"
+)
+
+var App groupApp
+
+// X404 404: "not found"
+func X404(lang string) string {
+	return i18n.GetStringTr(lang, "404")
+}
+
+// HelloWorld "hello world": "quoted"
+func HelloWorld(lang string) string {
+	return i18n.GetStringTr(lang, "\"hello world\"")
+}
+
+type groupApp struct {
+	Nested groupAppNested
+}
+
+// Enabled app.enabled: true
+func (groupApp) Enabled(lang string) bool {
+	return i18n.GetBoolTr(lang, "app.enabled")
+}
+
+// Greeting app.greeting: "Hello, %s! You have %d new messages"
+func (groupApp) Greeting(lang string, arg1 string, arg2 int) string {
+	return i18n.GetStringTrF(lang, "app.greeting", arg1, arg2)
+}
+
+// Limits app.limits: [1 2.5]
+func (groupApp) Limits(lang string) []float64 {
+	return i18n.GetSliceTr(lang, "app.limits", i18n.ConvertFloat)
+}
+
+// MaxUsers app.maxUsers: 100
+func (groupApp) MaxUsers(lang string) int64 {
+	return i18n.GetInt64Tr(lang, "app.maxUsers")
+}
+
+// Price app.price: "Price: %.2f"
+func (groupApp) Price(lang string, arg1 float64) string {
+	return i18n.GetStringTrF(lang, "app.price", arg1)
+}
+
+// Ratio app.ratio: 0.75
+func (groupApp) Ratio(lang string) float64 {
+	return i18n.GetFloatTr(lang, "app.ratio")
+}
+
+// Refer app.refer: "${app.title} is ready"
+func (groupApp) Refer(lang string) string {
+	return i18n.GetStringTr(lang, "app.refer")
+}
+
+// Reorder app.reorder: "%[2]s has %[1]d items"
+func (groupApp) Reorder(lang string, arg1 int, arg2 string) string {
+	return i18n.GetStringTrF(lang, "app.reorder", arg1, arg2)
+}
+
+// Tags app.tags: [a b]
+func (groupApp) Tags(lang string) []string {
+	return i18n.GetSliceTr(lang, "app.tags", i18n.ConvertString)
+}
+
+// Title app.title: "My App"
+func (groupApp) Title(lang string) string {
+	return i18n.GetStringTr(lang, "app.title")
+}
+
+// Welcome app.welcome: "Welcome back {name}, you have {count, number} new messages s..."
+func (groupApp) Welcome(lang string, name any, count float64, since time.Time) string {
+	return i18n.GetMessageTr(lang, "app.welcome", i18n.Args{"name": name, "count": count, "since": since})
+}
+
+type groupAppNested struct {
+}
+
+// Deep app.nested.deep: "deep value"
+func (groupAppNested) Deep(lang string) string {
+	return i18n.GetStringTr(lang, "app.nested.deep")
+}
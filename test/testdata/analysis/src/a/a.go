@@ -0,0 +1,31 @@
+package a
+
+import (
+	"github.com/hanakogo/i18n"
+)
+
+const helloPath = "main.hello"
+
+type level int
+
+func (l level) String() string { return "level" }
+
+func paths(dynamic string) {
+	i18n.GetString("main.hello")
+	i18n.GetString(helloPath)
+	i18n.GetString(dynamic)
+	i18n.GetString("tset.hello")                          // want `path "tset.hello" is not defined in default language \[en\]`
+	i18n.GetStringTr("zh-CN", "main.typo")                // want `path "main.typo" is not defined`
+	i18n.Get[string]("main.typo", i18n.ConvertString, "") // want `path "main.typo" is not defined`
+}
+
+func formats(args []any) {
+	i18n.GetStringF("main.count", 1)
+	i18n.GetStringF("main.count", level(1))
+	i18n.GetStringF("main.count", any("a"))
+	i18n.GetStringF("main.count", args...)
+	i18n.GetStringF("main.count", "a")          // want `verb %d in message of path "main.count" doesn't accept argument of type string`
+	i18n.GetStringF("main.count")               // want `message of path "main.count" needs 1 argument\(s\), but 0 are given`
+	i18n.GetStringTrF("en", "main.count", 1, 2) // want `message of path "main.count" needs 1 argument\(s\), but 2 are given`
+	i18n.GetStringTrF("en", "main.typo", 1)     // want `path "main.typo" is not defined`
+}
@@ -0,0 +1,16 @@
+// Package i18n is a stub of github.com/hanakogo/i18n for analysis test
+package i18n
+
+type ConvertFunc[T any] func(value any) T
+
+var ConvertString ConvertFunc[string]
+
+func Get[T any](path string, convertFunc ConvertFunc[T], def T) (val T) { return def }
+
+func GetString(path string, def ...string) string { return "" }
+
+func GetStringTr(lang string, path string, def ...string) string { return "" }
+
+func GetStringF(path string, args ...any) string { return "" }
+
+func GetStringTrF(lang string, path string, args ...any) string { return "" }
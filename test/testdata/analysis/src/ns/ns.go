@@ -0,0 +1,12 @@
+package ns
+
+import (
+	"github.com/hanakogo/i18n"
+)
+
+func paths() {
+	i18n.GetString("main.title")
+	i18n.GetStringF("billing.invoice.total", 1.5)
+	i18n.GetString("title")                  // want `path "title" is not defined in default language \[en\]`
+	i18n.GetStringF("billing.invoice.total") // want `message of path "billing.invoice.total" needs 1 argument\(s\), but 0 are given`
+}
@@ -0,0 +1,9 @@
+package main
+
+import (
+	t "github.com/hanakogo/i18n"
+)
+
+func alias() string {
+	return t.GetString(`main."cycleA"`)
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hanakogo/i18n"
+)
+
+func main() {
+	fmt.Println(i18n.GetString("main.hello"))
+	fmt.Println(i18n.GetStringTrF("en", "main.count", 1))
+	fmt.Println(i18n.Get[string]("main.typo", i18n.ConvertString, ""))
+
+	// dynamic path is ignored
+	path := "main.broken"
+	fmt.Println(i18n.GetString(path))
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hanakogo/i18n"
+)
+
+func TestMain(t *testing.T) {
+	i18n.GetString("main.broken")
+}